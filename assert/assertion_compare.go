@@ -0,0 +1,198 @@
+package assert
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// compare orders two values: numbers of any kind (including time.Duration),
+// strings, or time.Time values.  Integers of the same signedness are compared
+// exactly, and other numbers once converted with toFloat.
+// return (0, false) if a and b cannot be ordered, which is also the case
+// when one of them is NaN.
+// return (-1, true), (0, true) or (1, true) if a is less than, equal to or
+// greater than b.
+func compare(a, b interface{}) (int, bool) {
+
+	if at, ok := a.(time.Time); ok {
+		bt, ok := b.(time.Time)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case at.Before(bt):
+			return -1, true
+		case at.After(bt):
+			return 1, true
+		}
+		return 0, true
+	}
+	if _, ok := b.(time.Time); ok {
+		return 0, false
+	}
+
+	if a == nil || b == nil {
+		return 0, false
+	}
+	av := reflect.ValueOf(a)
+	bv := reflect.ValueOf(b)
+
+	switch {
+	case av.Kind() == reflect.String && bv.Kind() == reflect.String:
+		return strings.Compare(av.String(), bv.String()), true
+	case isInt(av) && isInt(bv):
+		return compareOrdered(av.Int() < bv.Int(), av.Int() > bv.Int()), true
+	case isUint(av) && isUint(bv):
+		return compareOrdered(av.Uint() < bv.Uint(), av.Uint() > bv.Uint()), true
+	}
+
+	af, aok := toFloat(a)
+	bf, bok := toFloat(b)
+	if !aok || !bok || math.IsNaN(af) || math.IsNaN(bf) {
+		return 0, false
+	}
+	return compareOrdered(af < bf, af > bf), true
+
+}
+
+// compareOrdered turns the results of < and > into the result of compare.
+func compareOrdered(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+	return 0
+}
+
+// isInt reports whether v holds a signed integer.
+func isInt(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+// isUint reports whether v holds an unsigned integer.
+func isUint(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+// formatOperand formats an operand of an ordering assertion for a failure
+// message.  Times and durations are printed as they read, everything else
+// as a Go value.
+func formatOperand(x interface{}) string {
+	switch x.(type) {
+	case time.Time, time.Duration:
+		return fmt.Sprintf("%v", x)
+	}
+	return fmt.Sprintf("%#v", x)
+}
+
+// assertOrder fails unless the result of comparing e1 with e2 is allowed.
+func assertOrder(t TestingT, assertion string, e1, e2 interface{}, allowed []int, relation string, msgAndArgs ...interface{}) bool {
+
+	result, ok := compare(e1, e2)
+	if !ok {
+		return Fail(t, fmt.Sprintf("%s cannot compare %#v and %#v: unsupported types %T and %T, expected two numbers, strings or times", assertion, e1, e2, e1, e2), msgAndArgs...)
+	}
+	if containsResult(allowed, result) {
+		return true
+	}
+
+	return Fail(t, fmt.Sprintf("%s is not %s %s", formatOperand(e1), relation, formatOperand(e2)), msgAndArgs...)
+
+}
+
+// Greater asserts that the first element is greater than the second.
+//
+//	assert.Greater(t, 2, 1)
+//	assert.Greater(t, float64(2), float32(1))
+//	assert.Greater(t, "b", "a")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Greater(t TestingT, e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return assertOrder(t, "Greater", e1, e2, []int{1}, "greater than", msgAndArgs...)
+}
+
+// GreaterOrEqual asserts that the first element is greater than or equal to the second.
+//
+//	assert.GreaterOrEqual(t, 2, 1)
+//	assert.GreaterOrEqual(t, 2, 2)
+//	assert.GreaterOrEqual(t, "b", "a")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func GreaterOrEqual(t TestingT, e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return assertOrder(t, "GreaterOrEqual", e1, e2, []int{1, 0}, "greater than or equal to", msgAndArgs...)
+}
+
+// Less asserts that the first element is less than the second.
+//
+//	assert.Less(t, 1, 2)
+//	assert.Less(t, float64(1), float32(2))
+//	assert.Less(t, "a", "b")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Less(t TestingT, e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return assertOrder(t, "Less", e1, e2, []int{-1}, "less than", msgAndArgs...)
+}
+
+// LessOrEqual asserts that the first element is less than or equal to the second.
+//
+//	assert.LessOrEqual(t, 1, 2)
+//	assert.LessOrEqual(t, 2, 2)
+//	assert.LessOrEqual(t, "a", "b")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func LessOrEqual(t TestingT, e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return assertOrder(t, "LessOrEqual", e1, e2, []int{-1, 0}, "less than or equal to", msgAndArgs...)
+}
+
+// Positive asserts that the specified number is greater than zero.
+//
+//	assert.Positive(t, 1)
+//	assert.Positive(t, time.Second)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Positive(t TestingT, e interface{}, msgAndArgs ...interface{}) bool {
+
+	f, ok := toFloat(e)
+	if !ok {
+		return Fail(t, unsupportedType("Positive", e, "a number"), msgAndArgs...)
+	}
+	if !(f > 0) {
+		return Fail(t, fmt.Sprintf("%s is not positive", formatOperand(e)), msgAndArgs...)
+	}
+
+	return true
+
+}
+
+// Negative asserts that the specified number is less than zero.
+//
+//	assert.Negative(t, -1)
+//	assert.Negative(t, -time.Second)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Negative(t TestingT, e interface{}, msgAndArgs ...interface{}) bool {
+
+	f, ok := toFloat(e)
+	if !ok {
+		return Fail(t, unsupportedType("Negative", e, "a number"), msgAndArgs...)
+	}
+	if !(f < 0) {
+		return Fail(t, fmt.Sprintf("%s is not negative", formatOperand(e)), msgAndArgs...)
+	}
+
+	return true
+
+}
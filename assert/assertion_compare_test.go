@@ -0,0 +1,140 @@
+package assert
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCompare(t *testing.T) {
+
+	now := time.Now()
+
+	for _, c := range []struct {
+		a, b   interface{}
+		result int
+	}{
+		{1, 2, -1},
+		{int8(2), int64(1), 1},
+		{uint(1), uint8(1), 0},
+		{1, 1.5, -1},
+		{float32(2.5), 2.5, 0},
+		{uint64(math.MaxUint64), uint64(math.MaxUint64 - 1), 1},
+		{int64(math.MaxInt64), int64(math.MaxInt64 - 1), 1},
+		{"a", "b", -1},
+		{"b", "b", 0},
+		{time.Second, time.Minute, -1},
+		{now, now.Add(-time.Second), 1},
+		{now, now, 0},
+	} {
+		result, ok := compare(c.a, c.b)
+		True(t, ok, "%#v and %#v should be ordered", c.a, c.b)
+		Equal(t, c.result, result, "comparing %#v with %#v", c.a, c.b)
+	}
+
+	for _, c := range []struct{ a, b interface{} }{
+		{1, "1"},
+		{now, 1},
+		{1, now},
+		{nil, 1},
+		{math.NaN(), 1.0},
+		{[]int{1}, []int{2}},
+	} {
+		_, ok := compare(c.a, c.b)
+		False(t, ok, "%#v and %#v should not be ordered", c.a, c.b)
+	}
+
+}
+
+func TestGreater(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !Greater(mockT, 2, 1) {
+		t.Error("Greater should return true: 2 is greater than 1")
+	}
+	if Greater(mockT, 1, 1) {
+		t.Error("Greater should return false: 1 is not greater than 1")
+	}
+	if !GreaterOrEqual(mockT, 1, 1) {
+		t.Error("GreaterOrEqual should return true: 1 is equal to 1")
+	}
+	if GreaterOrEqual(mockT, "a", "b") {
+		t.Error("GreaterOrEqual should return false: \"a\" is less than \"b\"")
+	}
+	if Greater(mockT, 2, "1") {
+		t.Error("Greater should return false: a number and a string cannot be compared")
+	}
+
+	bufT := new(bufferT)
+	Greater(bufT, time.Second, time.Minute)
+	Contains(t, bufT.buf.String(), "1s is not greater than 1m0s")
+
+	bufT = new(bufferT)
+	Greater(bufT, 1, []int{1})
+	Contains(t, bufT.buf.String(), "Greater cannot compare 1 and []int{1}: unsupported types int and []int")
+
+}
+
+func TestLess(t *testing.T) {
+
+	mockT := new(testing.T)
+	now := time.Now()
+
+	if !Less(mockT, now, now.Add(time.Second)) {
+		t.Error("Less should return true: now is before a second later")
+	}
+	if Less(mockT, 1.5, 1) {
+		t.Error("Less should return false: 1.5 is greater than 1")
+	}
+	if !LessOrEqual(mockT, uint8(1), uint16(1)) {
+		t.Error("LessOrEqual should return true: 1 is equal to 1")
+	}
+	if LessOrEqual(mockT, math.NaN(), 1.0) {
+		t.Error("LessOrEqual should return false: NaN cannot be compared")
+	}
+
+	bufT := new(bufferT)
+	LessOrEqual(bufT, "b", "a")
+	Contains(t, bufT.buf.String(), `"b" is not less than or equal to "a"`)
+
+}
+
+func TestPositive(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !Positive(mockT, 1) {
+		t.Error("Positive should return true: 1 is positive")
+	}
+	if !Positive(mockT, time.Second) {
+		t.Error("Positive should return true: 1s is positive")
+	}
+	if Positive(mockT, 0) {
+		t.Error("Positive should return false: 0 is not positive")
+	}
+	if Positive(mockT, math.NaN()) {
+		t.Error("Positive should return false: NaN is not positive")
+	}
+	if Positive(mockT, "1") {
+		t.Error("Positive should return false: \"1\" is not a number")
+	}
+
+}
+
+func TestNegative(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !Negative(mockT, -1.5) {
+		t.Error("Negative should return true: -1.5 is negative")
+	}
+	if Negative(mockT, uint(1)) {
+		t.Error("Negative should return false: 1 is not negative")
+	}
+
+	bufT := new(bufferT)
+	Negative(bufT, -time.Second+time.Minute)
+	Contains(t, bufT.buf.String(), "59s is not negative")
+
+}
@@ -0,0 +1,114 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// LessFunc reports whether the element of a list at index i must sort
+// before the element at index j, in the way of sort.Slice.
+type LessFunc func(i, j int) bool
+
+// assertListOrder fails unless comparing each element of list with the next
+// one gives an allowed result, and reports the first pair that does not.
+func assertListOrder(t TestingT, assertion string, list interface{}, allowed []int, order, relation string, msgAndArgs ...interface{}) bool {
+
+	elements, ok := listElements(list)
+	if !ok {
+		return Fail(t, unsupportedType(assertion, list, "an array or slice"), msgAndArgs...)
+	}
+
+	for i := 1; i < len(elements); i++ {
+		prev, next := elements[i-1], elements[i]
+		result, ok := compare(prev, next)
+		if !ok {
+			return Fail(t, fmt.Sprintf("%s cannot compare element %d (%#v) and element %d (%#v): unsupported types %T and %T, expected numbers, strings or times", assertion, i-1, prev, i, next, prev, next), msgAndArgs...)
+		}
+		if !containsResult(allowed, result) {
+			return Fail(t, fmt.Sprintf("%#v is not %s: element %d (%s) is not %s element %d (%s)", list, order, i-1, formatOperand(prev), relation, i, formatOperand(next)), msgAndArgs...)
+		}
+	}
+
+	return true
+
+}
+
+// containsResult reports whether result is one of the allowed results of compare.
+func containsResult(allowed []int, result int) bool {
+	for _, r := range allowed {
+		if result == r {
+			return true
+		}
+	}
+	return false
+}
+
+// IsIncreasing asserts that each element of the list is greater than the previous one.
+//
+//	assert.IsIncreasing(t, []int{1, 2, 3})
+//	assert.IsIncreasing(t, []string{"a", "b"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsIncreasing(t TestingT, list interface{}, msgAndArgs ...interface{}) bool {
+	return assertListOrder(t, "IsIncreasing", list, []int{-1}, "increasing", "less than", msgAndArgs...)
+}
+
+// IsNonIncreasing asserts that each element of the list is less than or equal to the previous one.
+//
+//	assert.IsNonIncreasing(t, []int{2, 1, 1})
+//	assert.IsNonIncreasing(t, []string{"b", "a"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsNonIncreasing(t TestingT, list interface{}, msgAndArgs ...interface{}) bool {
+	return assertListOrder(t, "IsNonIncreasing", list, []int{1, 0}, "non-increasing", "greater than or equal to", msgAndArgs...)
+}
+
+// IsDecreasing asserts that each element of the list is less than the previous one.
+//
+//	assert.IsDecreasing(t, []int{3, 2, 1})
+//	assert.IsDecreasing(t, []string{"b", "a"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsDecreasing(t TestingT, list interface{}, msgAndArgs ...interface{}) bool {
+	return assertListOrder(t, "IsDecreasing", list, []int{1}, "decreasing", "greater than", msgAndArgs...)
+}
+
+// IsNonDecreasing asserts that each element of the list is greater than or equal to the previous one.
+//
+//	assert.IsNonDecreasing(t, []int{1, 1, 2})
+//	assert.IsNonDecreasing(t, []string{"a", "b"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsNonDecreasing(t TestingT, list interface{}, msgAndArgs ...interface{}) bool {
+	return assertListOrder(t, "IsNonDecreasing", list, []int{-1, 0}, "non-decreasing", "less than or equal to", msgAndArgs...)
+}
+
+// Sorted asserts that the list (array or slice) is sorted according to less,
+// which reports whether the element at index i must sort before the element
+// at index j, as with sort.Slice.
+//
+//	assert.Sorted(t, people, func(i, j int) bool { return people[i].Age < people[j].Age })
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Sorted(t TestingT, list interface{}, less LessFunc, msgAndArgs ...interface{}) bool {
+
+	if list == nil {
+		return true
+	}
+	value := reflect.ValueOf(list)
+	if value.Kind() != reflect.Array && value.Kind() != reflect.Slice {
+		return Fail(t, unsupportedType("Sorted", list, "an array or slice"), msgAndArgs...)
+	}
+	if less == nil {
+		return Fail(t, "Sorted needs a less function", msgAndArgs...)
+	}
+
+	for i := 1; i < value.Len(); i++ {
+		if less(i, i-1) {
+			return Fail(t, fmt.Sprintf("%#v is not sorted: element %d (%#v) should sort before element %d (%#v)", list, i, value.Index(i).Interface(), i-1, value.Index(i-1).Interface()), msgAndArgs...)
+		}
+	}
+
+	return true
+
+}
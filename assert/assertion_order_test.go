@@ -0,0 +1,104 @@
+package assert
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsIncreasing(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !IsIncreasing(mockT, []int{1, 2, 3}) {
+		t.Error("IsIncreasing should return true: 1, 2, 3 is increasing")
+	}
+	if !IsIncreasing(mockT, []int{}) {
+		t.Error("IsIncreasing should return true: an empty list is increasing")
+	}
+	if IsIncreasing(mockT, []int{1, 1, 2}) {
+		t.Error("IsIncreasing should return false: 1, 1, 2 is not increasing")
+	}
+	if IsIncreasing(mockT, "abc") {
+		t.Error("IsIncreasing should return false: a string is not a list")
+	}
+
+	bufT := new(bufferT)
+	IsIncreasing(bufT, []string{"a", "c", "b"})
+	Contains(t, bufT.buf.String(), `[]string{"a", "c", "b"} is not increasing: element 1 ("c") is not less than element 2 ("b")`)
+
+	bufT = new(bufferT)
+	IsIncreasing(bufT, []interface{}{1, "a"})
+	Contains(t, bufT.buf.String(), `IsIncreasing cannot compare element 0 (1) and element 1 ("a")`)
+
+}
+
+func TestIsNonIncreasing(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !IsNonIncreasing(mockT, []float64{2.5, 2.5, 1}) {
+		t.Error("IsNonIncreasing should return true: 2.5, 2.5, 1 is non-increasing")
+	}
+	if IsNonIncreasing(mockT, [3]int{3, 2, 4}) {
+		t.Error("IsNonIncreasing should return false: 3, 2, 4 is not non-increasing")
+	}
+
+}
+
+func TestIsDecreasing(t *testing.T) {
+
+	mockT := new(testing.T)
+	now := time.Now()
+
+	if !IsDecreasing(mockT, []time.Time{now, now.Add(-time.Second)}) {
+		t.Error("IsDecreasing should return true: the times are decreasing")
+	}
+	if IsDecreasing(mockT, []time.Duration{time.Second, time.Second}) {
+		t.Error("IsDecreasing should return false: 1s, 1s is not decreasing")
+	}
+
+	bufT := new(bufferT)
+	IsDecreasing(bufT, []time.Duration{time.Minute, time.Second, time.Hour})
+	Contains(t, bufT.buf.String(), "element 1 (1s) is not greater than element 2 (1h0m0s)")
+
+}
+
+func TestIsNonDecreasing(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !IsNonDecreasing(mockT, []uint{1, 1, 2}) {
+		t.Error("IsNonDecreasing should return true: 1, 1, 2 is non-decreasing")
+	}
+	if IsNonDecreasing(mockT, []string{"b", "a"}) {
+		t.Error("IsNonDecreasing should return false: \"b\", \"a\" is not non-decreasing")
+	}
+
+}
+
+func TestSorted(t *testing.T) {
+
+	mockT := new(testing.T)
+	people := []A{{"Ann", "30"}, {"Bob", "25"}, {"Cid", "40"}}
+
+	byName := func(i, j int) bool { return people[i].Name < people[j].Name }
+	byValue := func(i, j int) bool { return people[i].Value < people[j].Value }
+
+	if !Sorted(mockT, people, byName) {
+		t.Error("Sorted should return true: people are sorted by name")
+	}
+	if Sorted(mockT, people, byValue) {
+		t.Error("Sorted should return false: people are not sorted by value")
+	}
+	if Sorted(mockT, 1, byName) {
+		t.Error("Sorted should return false: 1 is not a list")
+	}
+	if Sorted(mockT, people, nil) {
+		t.Error("Sorted should return false: there is no less function")
+	}
+
+	bufT := new(bufferT)
+	Sorted(bufT, people, byValue)
+	Contains(t, bufT.buf.String(), `element 1 (assert.A{Name:"Bob", Value:"25"}) should sort before element 0 (assert.A{Name:"Ann", Value:"30"})`)
+
+}
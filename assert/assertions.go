@@ -3,11 +3,15 @@ package assert
 import (
 	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"regexp"
 	"runtime"
+	"runtime/debug"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -67,14 +71,19 @@ func CallerInfo() string {
 	ok := false
 
 	for i := 0; ; i++ {
-		_, file, line, ok = runtime.Caller(i)
+		var pc uintptr
+		pc, file, line, ok = runtime.Caller(i)
 		if !ok {
 			return ""
 		}
+		function := ""
+		if f := runtime.FuncForPC(pc); f != nil {
+			function = f.Name()
+		}
 		parts := strings.Split(file, "/")
 		dir := parts[len(parts)-2]
 		file = parts[len(parts)-1]
-		if (dir != "assert" && dir != "mock" && dir != "require") || file == "mock_test.go" {
+		if !isTestifyFrame(function, dir, file) {
 			break
 		}
 	}
@@ -82,6 +91,28 @@ func CallerInfo() string {
 	return fmt.Sprintf("%s:%d", file, line)
 }
 
+// suitePackage prefixes the names of the functions of the suite package.
+const suitePackage = "github.com/stretchr/testify/suite."
+
+// isTestifyFrame reports whether a stack frame of the specified function, in
+// the specified file and directory, belongs to testify rather than to the code
+// calling it.  The suite package calls assertions on behalf of its users, as in
+// Suite.GoldenFile, so its frames are skipped too, except for its own tests.
+// They are told by the import path of the function, since users may well have
+// packages called suite of their own.
+func isTestifyFrame(function, dir, file string) bool {
+	switch dir {
+	case "assert", "require":
+		return true
+	case "mock":
+		return file != "mock_test.go"
+	}
+	if strings.HasPrefix(function, suitePackage) || strings.Contains(function, "/vendor/"+suitePackage) {
+		return !strings.HasSuffix(file, "_test.go")
+	}
+	return false
+}
+
 // getWhitespaceString returns a string that is long enough to overwrite the default
 // output from the go testing framework.
 func getWhitespaceString() string {
@@ -216,6 +247,69 @@ func Exactly(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}
 
 }
 
+// formatPointer formats a pointer for a failure message: its address and the
+// value it points to.
+func formatPointer(pointer interface{}) string {
+	value := reflect.ValueOf(pointer)
+	if value.IsNil() {
+		return fmt.Sprintf("%p (nil)", pointer)
+	}
+	return fmt.Sprintf("%p (%#v)", pointer, value.Elem().Interface())
+}
+
+// checkPointers fails unless expected and actual are both pointers.
+func checkPointers(t TestingT, assertion string, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if expected == nil || reflect.TypeOf(expected).Kind() != reflect.Ptr {
+		return Fail(t, unsupportedType(assertion, expected, "a pointer"), msgAndArgs...)
+	}
+	if actual == nil || reflect.TypeOf(actual).Kind() != reflect.Ptr {
+		return Fail(t, unsupportedType(assertion, actual, "a pointer"), msgAndArgs...)
+	}
+	return true
+}
+
+// Same asserts that two pointers have the same type and point to the same
+// object (i.e. hold the same address).
+//
+//    assert.Same(t, ptr1, ptr2, "ptr1 and ptr2 should point to the same object")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Same(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+
+	if !checkPointers(t, "Same", expected, actual, msgAndArgs...) {
+		return false
+	}
+
+	if expected != actual {
+		return Fail(t, fmt.Sprintf("Not same: %s (expected)\n"+
+			"        != %s (actual)", formatPointer(expected), formatPointer(actual)), msgAndArgs...)
+	}
+
+	return true
+
+}
+
+// NotSame asserts that two pointers do not point to the same object (i.e. do
+// not hold the same address), or have different types.
+//
+//    assert.NotSame(t, ptr1, ptr2, "ptr1 and ptr2 should not point to the same object")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotSame(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+
+	if !checkPointers(t, "NotSame", expected, actual, msgAndArgs...) {
+		return false
+	}
+
+	if expected == actual {
+		return Fail(t, fmt.Sprintf("Should not be same: %s (expected)\n"+
+			"        == %s (actual)", formatPointer(expected), formatPointer(actual)), msgAndArgs...)
+	}
+
+	return true
+
+}
+
 // NotNil asserts that the specified object is not nil.
 //
 //    assert.NotNil(t, err, "err should be something")
@@ -269,62 +363,50 @@ func Nil(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
 	return Fail(t, fmt.Sprintf("Expected nil, but got: %#v", object), msgAndArgs...)
 }
 
-var zeros = []interface{}{
-	int(0),
-	int8(0),
-	int16(0),
-	int32(0),
-	int64(0),
-	uint(0),
-	uint8(0),
-	uint16(0),
-	uint32(0),
-	uint64(0),
-	float32(0),
-	float64(0),
+// isZero gets whether the specified object is nil or the zero value of its type.
+func isZero(object interface{}) bool {
+
+	if object == nil {
+		return true
+	}
+
+	zero := reflect.Zero(reflect.TypeOf(object)).Interface()
+	return reflect.DeepEqual(object, zero)
+
 }
 
-// isEmpty gets whether the specified object is considered empty or not.
+// isEmpty gets whether the specified object is considered empty or not:
+//
+//   - nil is empty;
+//   - a slice, a map or a channel is empty when its length is zero, even if it
+//     is not nil;
+//   - a pointer is empty when it is nil or when it points to an empty object;
+//   - anything else, including strings, numbers, booleans, arrays and structs,
+//     is empty when it is the zero value of its type, so an array is empty when
+//     all its elements are zero.
 func isEmpty(object interface{}) bool {
 
 	if object == nil {
 		return true
-	} else if object == "" {
-		return true
-	} else if object == false {
-		return true
-	}
-
-	for _, v := range zeros {
-		if object == v {
-			return true
-		}
 	}
 
 	objValue := reflect.ValueOf(object)
 
 	switch objValue.Kind() {
-	case reflect.Map:
-		fallthrough
-	case reflect.Slice, reflect.Chan:
-		{
-			return (objValue.Len() == 0)
-		}
+	case reflect.Map, reflect.Slice, reflect.Chan:
+		return objValue.Len() == 0
 	case reflect.Ptr:
-		{
-			switch object.(type) {
-			case *time.Time:
-				return object.(*time.Time).IsZero()
-			default:
-				return false
-			}
+		if objValue.IsNil() {
+			return true
 		}
+		return isEmpty(objValue.Elem().Interface())
 	}
-	return false
+	return isZero(object)
 }
 
-// Empty asserts that the specified object is empty.  I.e. nil, "", false, 0 or either
-// a slice or a channel with len == 0.
+// Empty asserts that the specified object is empty.  I.e. nil, "", false, 0, a
+// slice, a map or a channel with len == 0, an array or a struct whose fields are
+// all zero, or a pointer to an empty object.
 //
 // assert.Empty(t, obj)
 //
@@ -340,8 +422,9 @@ func Empty(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
 
 }
 
-// NotEmpty asserts that the specified object is NOT empty.  I.e. not nil, "", false, 0 or either
-// a slice or a channel with len == 0.
+// NotEmpty asserts that the specified object is NOT empty.  I.e. not nil, "", false,
+// 0, a slice, a map or a channel with len == 0, an array or a struct whose fields are
+// all zero, or a pointer to an empty object.
 //
 // if assert.NotEmpty(t, obj) {
 //   assert.Equal(t, "two", obj[1])
@@ -359,6 +442,39 @@ func NotEmpty(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
 
 }
 
+// Zero asserts that the specified object is nil or the zero value of its type.
+// Unlike with Empty, a non-nil slice, map or channel is not zero, even when it
+// has no elements, and neither is a non-nil pointer.
+//
+//    assert.Zero(t, obj)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Zero(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
+
+	if !isZero(object) {
+		return Fail(t, fmt.Sprintf("Should be zero, but was %#v", object), msgAndArgs...)
+	}
+
+	return true
+
+}
+
+// NotZero asserts that the specified object is neither nil nor the zero value
+// of its type.
+//
+//    assert.NotZero(t, obj)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotZero(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
+
+	if isZero(object) {
+		return Fail(t, fmt.Sprintf("Should not be zero, but was %#v", object), msgAndArgs...)
+	}
+
+	return true
+
+}
+
 // getLen try to get length of object.
 // return (false, 0) if impossible.
 func getLen(x interface{}) (ok bool, length int) {
@@ -434,12 +550,17 @@ func NotEqual(t TestingT, expected, actual interface{}, msgAndArgs ...interface{
 
 }
 
-// containsElement try loop over the list check if the list includes the element.
+// includeElement try loop over the list check if the list includes the element.
+// A string includes its substrings, and a map includes its keys.
 // return (false, false) if impossible.
 // return (true, false) if element was not found.
 // return (true, true) if element was found.
 func includeElement(list interface{}, element interface{}) (ok, found bool) {
 
+	if list == nil {
+		return false, false
+	}
+
 	listValue := reflect.ValueOf(list)
 	elementValue := reflect.ValueOf(element)
 	defer func() {
@@ -449,12 +570,41 @@ func includeElement(list interface{}, element interface{}) (ok, found bool) {
 		}
 	}()
 
-	if reflect.TypeOf(list).Kind() == reflect.String {
+	switch listValue.Kind() {
+	case reflect.String:
 		return true, strings.Contains(listValue.String(), elementValue.String())
+	case reflect.Map:
+		for _, key := range listValue.MapKeys() {
+			if ObjectsAreEqual(key.Interface(), element) {
+				return true, true
+			}
+		}
+		return true, false
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < listValue.Len(); i++ {
+			if ObjectsAreEqual(listValue.Index(i).Interface(), element) {
+				return true, true
+			}
+		}
+		return true, false
 	}
+	return false, false
+
+}
+
+// includeValue checks if the map includes the value.
+// return (false, false) if m is not a map.
+// return (true, false) if value was not found.
+// return (true, true) if value was found.
+func includeValue(m interface{}, value interface{}) (ok, found bool) {
 
-	for i := 0; i < listValue.Len(); i++ {
-		if ObjectsAreEqual(listValue.Index(i).Interface(), element) {
+	if m == nil || reflect.TypeOf(m).Kind() != reflect.Map {
+		return false, false
+	}
+
+	mapValue := reflect.ValueOf(m)
+	for _, key := range mapValue.MapKeys() {
+		if ObjectsAreEqual(mapValue.MapIndex(key).Interface(), value) {
 			return true, true
 		}
 	}
@@ -462,18 +612,25 @@ func includeElement(list interface{}, element interface{}) (ok, found bool) {
 
 }
 
-// Contains asserts that the specified string or list(array, slice...) contains the
-// specified substring or element.
+// unsupportedType returns the message of an assertion that fails because
+// it cannot be applied to the type of object.
+func unsupportedType(assertion string, object interface{}, supported string) string {
+	return fmt.Sprintf("%s cannot be applied to %#v: unsupported type %T, expected %s", assertion, object, object, supported)
+}
+
+// Contains asserts that the specified string, list(array, slice...) or map contains the
+// specified substring, element or key.
 //
 //    assert.Contains(t, "Hello World", "World", "But 'Hello World' does contain 'World'")
 //    assert.Contains(t, ["Hello", "World"], "World", "But ["Hello", "World"] does contain 'World'")
+//    assert.Contains(t, {"Hello": "World"}, "Hello", "But {'Hello': 'World'} does contain 'Hello'")
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Contains(t TestingT, s, contains interface{}, msgAndArgs ...interface{}) bool {
 
 	ok, found := includeElement(s, contains)
 	if !ok {
-		return Fail(t, fmt.Sprintf("\"%s\" could not be applied builtin len()", s), msgAndArgs...)
+		return Fail(t, unsupportedType("Contains", s, "a string, array, slice or map"), msgAndArgs...)
 	}
 	if !found {
 		return Fail(t, fmt.Sprintf("\"%s\" does not contain \"%s\"", s, contains), msgAndArgs...)
@@ -483,18 +640,19 @@ func Contains(t TestingT, s, contains interface{}, msgAndArgs ...interface{}) bo
 
 }
 
-// NotContains asserts that the specified string or list(array, slice...) does NOT contain the
-// specified substring or element.
+// NotContains asserts that the specified string, list(array, slice...) or map does NOT contain the
+// specified substring, element or key.
 //
 //    assert.NotContains(t, "Hello World", "Earth", "But 'Hello World' does NOT contain 'Earth'")
 //    assert.NotContains(t, ["Hello", "World"], "Earth", "But ['Hello', 'World'] does NOT contain 'Earth'")
+//    assert.NotContains(t, {"Hello": "World"}, "Earth", "But {'Hello': 'World'} does NOT contain 'Earth'")
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotContains(t TestingT, s, contains interface{}, msgAndArgs ...interface{}) bool {
 
 	ok, found := includeElement(s, contains)
 	if !ok {
-		return Fail(t, fmt.Sprintf("\"%s\" could not be applied builtin len()", s), msgAndArgs...)
+		return Fail(t, unsupportedType("NotContains", s, "a string, array, slice or map"), msgAndArgs...)
 	}
 	if found {
 		return Fail(t, fmt.Sprintf("\"%s\" should not contain \"%s\"", s, contains), msgAndArgs...)
@@ -504,6 +662,228 @@ func NotContains(t TestingT, s, contains interface{}, msgAndArgs ...interface{})
 
 }
 
+// ContainsKey asserts that the specified map has the specified key.
+//
+//    assert.ContainsKey(t, {"Hello": "World"}, "Hello", "But {'Hello': 'World'} does have the key 'Hello'")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ContainsKey(t TestingT, m, key interface{}, msgAndArgs ...interface{}) bool {
+
+	if m == nil || reflect.TypeOf(m).Kind() != reflect.Map {
+		return Fail(t, unsupportedType("ContainsKey", m, "a map"), msgAndArgs...)
+	}
+	_, found := includeElement(m, key)
+	if !found {
+		return Fail(t, fmt.Sprintf("%#v does not have the key %#v", m, key), msgAndArgs...)
+	}
+
+	return true
+
+}
+
+// ContainsValue asserts that the specified map has the specified value for some key.
+//
+//    assert.ContainsValue(t, {"Hello": "World"}, "World", "But {'Hello': 'World'} does have the value 'World'")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ContainsValue(t TestingT, m, value interface{}, msgAndArgs ...interface{}) bool {
+
+	ok, found := includeValue(m, value)
+	if !ok {
+		return Fail(t, unsupportedType("ContainsValue", m, "a map"), msgAndArgs...)
+	}
+	if !found {
+		return Fail(t, fmt.Sprintf("%#v does not have the value %#v", m, value), msgAndArgs...)
+	}
+
+	return true
+
+}
+
+// listElements returns the elements of the specified array or slice.
+// A nil object is an empty list.
+// return (nil, false) if object is not a list.
+func listElements(object interface{}) ([]interface{}, bool) {
+
+	if object == nil {
+		return nil, true
+	}
+
+	value := reflect.ValueOf(object)
+	if value.Kind() != reflect.Array && value.Kind() != reflect.Slice {
+		return nil, false
+	}
+
+	elements := make([]interface{}, value.Len())
+	for i := range elements {
+		elements[i] = value.Index(i).Interface()
+	}
+	return elements, true
+
+}
+
+// diffElements compares two lists regardless of the order of their
+// elements, counting duplicates.  It returns the elements of expected
+// that are missing from actual, and the extra elements of actual that
+// are not in expected.
+func diffElements(expected, actual []interface{}) (missing, extra []interface{}) {
+
+	matched := make([]bool, len(actual))
+	for _, e := range expected {
+		found := false
+		for i, a := range actual {
+			if !matched[i] && ObjectsAreEqual(e, a) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, e)
+		}
+	}
+
+	for i, a := range actual {
+		if !matched[i] {
+			extra = append(extra, a)
+		}
+	}
+	return missing, extra
+
+}
+
+// ElementsMatch asserts that the specified lists (arrays or slices) contain
+// the same elements, regardless of their order.  Duplicates are counted, so
+// each element must appear the same number of times in both lists.
+//
+//    assert.ElementsMatch(t, []int{1, 3, 2, 3}, []int{3, 3, 1, 2}, "The lists should have the same elements")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ElementsMatch(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+
+	expectedElements, ok := listElements(expected)
+	if !ok {
+		return Fail(t, unsupportedType("ElementsMatch", expected, "an array or slice"), msgAndArgs...)
+	}
+	actualElements, ok := listElements(actual)
+	if !ok {
+		return Fail(t, unsupportedType("ElementsMatch", actual, "an array or slice"), msgAndArgs...)
+	}
+
+	missing, extra := diffElements(expectedElements, actualElements)
+	if len(missing) == 0 && len(extra) == 0 {
+		return true
+	}
+
+	message := fmt.Sprintf("Elements do not match: %#v (expected)\n"+
+		"        != %#v (actual)", expected, actual)
+	if len(missing) > 0 {
+		message += fmt.Sprintf("\n\tmissing from actual: %#v", missing)
+	}
+	if len(extra) > 0 {
+		message += fmt.Sprintf("\n\textra in actual: %#v", extra)
+	}
+	return Fail(t, message, msgAndArgs...)
+
+}
+
+// subsetMissing returns what subset has that list does not: the elements
+// of subset not found in list when both are lists, or the entries of
+// subset whose key is not in list or holds another value when both are
+// maps.
+// return (nil, false) if list and subset are not both lists or both maps
+// with the same type of keys.
+func subsetMissing(list, subset interface{}) (missing interface{}, ok bool) {
+
+	listValue := reflect.ValueOf(list)
+	subsetValue := reflect.ValueOf(subset)
+
+	if listValue.Kind() == reflect.Map && subsetValue.Kind() == reflect.Map {
+		if listValue.Type().Key() != subsetValue.Type().Key() {
+			return nil, false
+		}
+		entries := reflect.MakeMap(subsetValue.Type())
+		for _, key := range subsetValue.MapKeys() {
+			value := listValue.MapIndex(key)
+			if !value.IsValid() || !ObjectsAreEqual(subsetValue.MapIndex(key).Interface(), value.Interface()) {
+				entries.SetMapIndex(key, subsetValue.MapIndex(key))
+			}
+		}
+		if entries.Len() == 0 {
+			return nil, true
+		}
+		return entries.Interface(), true
+	}
+
+	listItems, listOk := listElements(list)
+	subsetItems, subsetOk := listElements(subset)
+	if !listOk || !subsetOk {
+		return nil, false
+	}
+
+	var elements []interface{}
+	for _, element := range subsetItems {
+		found := false
+		for _, candidate := range listItems {
+			if ObjectsAreEqual(element, candidate) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			elements = append(elements, element)
+		}
+	}
+	if len(elements) == 0 {
+		return nil, true
+	}
+	return elements, true
+
+}
+
+// Subset asserts that every element of subset is contained in list, when
+// both are lists (arrays or slices), or that every entry of subset is in
+// list with an equal value, when both are maps.  Duplicates are not counted.
+//
+//    assert.Subset(t, []int{1, 2, 3}, []int{3, 1}, "1 and 3 should be in the list")
+//    assert.Subset(t, map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Subset(t TestingT, list, subset interface{}, msgAndArgs ...interface{}) bool {
+
+	missing, ok := subsetMissing(list, subset)
+	if !ok {
+		return Fail(t, fmt.Sprintf("%#v and %#v should both be lists (arrays or slices) or both be maps with the same type of keys", list, subset), msgAndArgs...)
+	}
+	if missing != nil {
+		return Fail(t, fmt.Sprintf("%#v is not a subset of %#v\n\tmissing: %#v", subset, list, missing), msgAndArgs...)
+	}
+
+	return true
+
+}
+
+// NotSubset asserts that some element of subset is not contained in list,
+// when both are lists (arrays or slices), or that some entry of subset is
+// not in list with an equal value, when both are maps.
+//
+//    assert.NotSubset(t, []int{1, 2, 3}, []int{4, 1}, "4 should not be in the list")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotSubset(t TestingT, list, subset interface{}, msgAndArgs ...interface{}) bool {
+
+	missing, ok := subsetMissing(list, subset)
+	if !ok {
+		return Fail(t, fmt.Sprintf("%#v and %#v should both be lists (arrays or slices) or both be maps with the same type of keys", list, subset), msgAndArgs...)
+	}
+	if missing == nil {
+		return Fail(t, fmt.Sprintf("%#v should not be a subset of %#v", subset, list), msgAndArgs...)
+	}
+
+	return true
+
+}
+
 // Condition uses a Comparison to assert a complex condition.
 func Condition(t TestingT, comp Comparison, msgAndArgs ...interface{}) bool {
 	result := comp()
@@ -518,27 +898,39 @@ func Condition(t TestingT, comp Comparison, msgAndArgs ...interface{}) bool {
 type PanicTestFunc func()
 
 // didPanic returns true if the function passed to it panics. Otherwise, it returns false.
-func didPanic(f PanicTestFunc) (bool, interface{}) {
+// When it panics, didPanic also returns the recovered value and the stack of the panic.
+func didPanic(f PanicTestFunc) (bool, interface{}, string) {
 
-	didPanic := false
+	didPanic := true
 	var message interface{}
+	var stack string
 	func() {
 
 		defer func() {
-			if message = recover(); message != nil {
-				didPanic = true
+			if didPanic {
+				message = recover()
+				stack = string(debug.Stack())
 			}
 		}()
 
 		// call the target function
 		f()
+		didPanic = false
 
 	}()
 
-	return didPanic, message
+	return didPanic, message, stack
 
 }
 
+// funcName returns the name of the function f, such as "pkg.TestSomething.func1".
+func funcName(f PanicTestFunc) string {
+	if fn := runtime.FuncForPC(reflect.ValueOf(f).Pointer()); fn != nil {
+		return fn.Name()
+	}
+	return fmt.Sprintf("%p", f)
+}
+
 // Panics asserts that the code inside the specified PanicTestFunc panics.
 //
 //   assert.Panics(t, func(){
@@ -548,8 +940,67 @@ func didPanic(f PanicTestFunc) (bool, interface{}) {
 // Returns whether the assertion was successful (true) or not (false).
 func Panics(t TestingT, f PanicTestFunc, msgAndArgs ...interface{}) bool {
 
-	if funcDidPanic, panicValue := didPanic(f); !funcDidPanic {
-		return Fail(t, fmt.Sprintf("func %#v should panic\n\r\tPanic value:\t%v", f, panicValue), msgAndArgs...)
+	if funcDidPanic, _, _ := didPanic(f); !funcDidPanic {
+		return Fail(t, fmt.Sprintf("func %s should panic", funcName(f)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// PanicsWithValue asserts that the code inside the specified PanicTestFunc panics,
+// and that the recovered panic value equals the expected value.
+//
+//   assert.PanicsWithValue(t, "crazy error", func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with \"crazy error\"")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func PanicsWithValue(t TestingT, expected interface{}, f PanicTestFunc, msgAndArgs ...interface{}) bool {
+
+	funcDidPanic, panicValue, panicStack := didPanic(f)
+	if !funcDidPanic {
+		return Fail(t, fmt.Sprintf("func %s should panic with value:\t%#v", funcName(f), expected), msgAndArgs...)
+	}
+	if !ObjectsAreEqual(expected, panicValue) {
+		return Fail(t, fmt.Sprintf("func %s should panic with value:\t%#v\n\r\tPanic value:\t%#v\n\r\tPanic stack:\t%s", funcName(f), expected, panicValue, panicStack), msgAndArgs...)
+	}
+
+	return true
+}
+
+// PanicsWithError asserts that the code inside the specified PanicTestFunc panics
+// with an error.  When expected is a string, the message of the error must equal
+// it; when expected is an error, the error must match it, as errors.Is does
+// through the chain of wrapped errors.
+//
+//   assert.PanicsWithError(t, "crazy error", func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with the \"crazy error\" error")
+//   assert.PanicsWithError(t, ErrCrazy, func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with ErrCrazy")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func PanicsWithError(t TestingT, expected interface{}, f PanicTestFunc, msgAndArgs ...interface{}) bool {
+
+	expectedErr, isErr := expected.(error)
+	expectedMessage, isMessage := expected.(string)
+	if !isErr && !isMessage {
+		return Fail(t, unsupportedType("PanicsWithError", expected, "a string or an error"), msgAndArgs...)
+	}
+
+	funcDidPanic, panicValue, panicStack := didPanic(f)
+	if !funcDidPanic {
+		return Fail(t, fmt.Sprintf("func %s should panic with error:\t%v", funcName(f), expected), msgAndArgs...)
+	}
+
+	panicErr, ok := panicValue.(error)
+	switch {
+	case !ok:
+		return Fail(t, fmt.Sprintf("func %s should panic with error:\t%v\n\r\tPanic value:\t%#v (not an error)\n\r\tPanic stack:\t%s", funcName(f), expected, panicValue, panicStack), msgAndArgs...)
+	case isMessage && panicErr.Error() != expectedMessage,
+		isErr && !errors.Is(panicErr, expectedErr):
+		return Fail(t, fmt.Sprintf("func %s should panic with error:\t%v\n\r\tPanic error:\t%v\n\r\tPanic stack:\t%s", funcName(f), expected, panicErr, panicStack), msgAndArgs...)
 	}
 
 	return true
@@ -564,8 +1015,8 @@ func Panics(t TestingT, f PanicTestFunc, msgAndArgs ...interface{}) bool {
 // Returns whether the assertion was successful (true) or not (false).
 func NotPanics(t TestingT, f PanicTestFunc, msgAndArgs ...interface{}) bool {
 
-	if funcDidPanic, panicValue := didPanic(f); funcDidPanic {
-		return Fail(t, fmt.Sprintf("func %#v should not panic\n\r\tPanic value:\t%v", f, panicValue), msgAndArgs...)
+	if funcDidPanic, panicValue, panicStack := didPanic(f); funcDidPanic {
+		return Fail(t, fmt.Sprintf("func %s should not panic\n\r\tPanic value:\t%#v\n\r\tPanic stack:\t%s", funcName(f), panicValue, panicStack), msgAndArgs...)
 	}
 
 	return true
@@ -586,94 +1037,279 @@ func WithinDuration(t TestingT, expected, actual time.Time, delta time.Duration,
 	return true
 }
 
+// toFloat converts a number of any kind, including named types such as
+// time.Duration, to a float64.
+// return (0, false) if x is not a number.
 func toFloat(x interface{}) (float64, bool) {
-	var xf float64
-	xok := true
-
-	switch xn := x.(type) {
-	case uint8:
-		xf = float64(xn)
-	case uint16:
-		xf = float64(xn)
-	case uint32:
-		xf = float64(xn)
-	case uint64:
-		xf = float64(xn)
-	case int:
-		xf = float64(xn)
-	case int8:
-		xf = float64(xn)
-	case int16:
-		xf = float64(xn)
-	case int32:
-		xf = float64(xn)
-	case int64:
-		xf = float64(xn)
-	case float32:
-		xf = float64(xn)
-	case float64:
-		xf = float64(xn)
-	default:
-		xok = false
-	}
-
-	return xf, xok
+
+	if x == nil {
+		return 0, false
+	}
+
+	xv := reflect.ValueOf(x)
+	switch xv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(xv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(xv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return xv.Float(), true
+	}
+	return 0, false
+
+}
+
+// inDelta checks that expected and actual are numbers within delta of each other.
+// NaN is not within any delta of anything, and an infinity is only within delta
+// of the same infinity.
+// return ("", true) if they are, or the failure message and false otherwise.
+func inDelta(expected, actual interface{}, delta float64) (string, bool) {
+
+	af, aok := toFloat(expected)
+	bf, bok := toFloat(actual)
+
+	if !aok || !bok {
+		return "Parameters must be numerical", false
+	}
+	if math.IsNaN(delta) || delta < 0 {
+		return fmt.Sprintf("Delta must be zero or positive, but was %v", delta), false
+	}
+	if math.IsNaN(af) || math.IsNaN(bf) {
+		return fmt.Sprintf("Expected %v and %v to be within %v of each other, but NaN is never close to anything", expected, actual, delta), false
+	}
+	if math.IsInf(af, 0) || math.IsInf(bf, 0) {
+		if af != bf {
+			return fmt.Sprintf("Expected %v and %v to be within %v of each other, but an infinity is only close to the same infinity", expected, actual, delta), false
+		}
+		return "", true
+	}
+
+	dt := af - bf
+	if dt < -delta || dt > delta {
+		return fmt.Sprintf("Max difference between %v and %v allowed is %v, but difference was %v", expected, actual, delta, dt), false
+	}
+
+	return "", true
 }
 
 // InDelta asserts that the two numerals are within delta of each other.
+// NaN is not within any delta of anything, and an infinity is only within
+// delta of the same infinity.
 //
 // 	 assert.InDelta(t, math.Pi, (22 / 7.0), 0.01)
 //
 // Returns whether the assertion was successful (true) or not (false).
 func InDelta(t TestingT, expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
 
-	af, aok := toFloat(expected)
-	bf, bok := toFloat(actual)
+	if message, ok := inDelta(expected, actual, delta); !ok {
+		return Fail(t, message, msgAndArgs...)
+	}
 
-	if !aok || !bok {
-		return Fail(t, fmt.Sprintf("Parameters must be numerical"), msgAndArgs...)
+	return true
+}
+
+// InDeltaSlice asserts that the two lists (arrays or slices) have the same
+// length, and that their elements at the same index are within delta of
+// each other.
+//
+// 	 assert.InDeltaSlice(t, []float64{math.Pi, math.E}, []float64{3.14, 2.72}, 0.01)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func InDeltaSlice(t TestingT, expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
+
+	expectedElements, actualElements, ok := numberLists(t, "InDeltaSlice", expected, actual, msgAndArgs...)
+	if !ok {
+		return false
 	}
 
-	dt := af - bf
-	if dt < -delta || dt > delta {
-		return Fail(t, fmt.Sprintf("Max difference between %v and %v allowed is %v, but difference was %v", expected, actual, delta, dt), msgAndArgs...)
+	for i := range expectedElements {
+		if message, ok := inDelta(expectedElements[i], actualElements[i], delta); !ok {
+			return Fail(t, fmt.Sprintf("Element %d: %s", i, message), msgAndArgs...)
+		}
+	}
+
+	return true
+}
+
+// InDeltaMapValues asserts that the two maps have the same keys, and that
+// their values for the same key are within delta of each other.
+//
+// 	 assert.InDeltaMapValues(t, map[string]float64{"pi": math.Pi}, map[string]float64{"pi": 3.14}, 0.01)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func InDeltaMapValues(t TestingT, expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
+
+	if expected == nil || reflect.TypeOf(expected).Kind() != reflect.Map {
+		return Fail(t, unsupportedType("InDeltaMapValues", expected, "a map"), msgAndArgs...)
+	}
+	if actual == nil || reflect.TypeOf(actual).Kind() != reflect.Map {
+		return Fail(t, unsupportedType("InDeltaMapValues", actual, "a map"), msgAndArgs...)
+	}
+
+	expectedMap := reflect.ValueOf(expected)
+	actualMap := reflect.ValueOf(actual)
+	if expectedMap.Type().Key() != actualMap.Type().Key() {
+		return Fail(t, fmt.Sprintf("Keys of %#v and %#v have different types: %v (expected) != %v (actual)", expected, actual, expectedMap.Type().Key(), actualMap.Type().Key()), msgAndArgs...)
+	}
+
+	for _, key := range expectedMap.MapKeys() {
+		actualValue := actualMap.MapIndex(key)
+		if !actualValue.IsValid() {
+			return Fail(t, fmt.Sprintf("Key %#v: missing from actual", key.Interface()), msgAndArgs...)
+		}
+		if message, ok := inDelta(expectedMap.MapIndex(key).Interface(), actualValue.Interface(), delta); !ok {
+			return Fail(t, fmt.Sprintf("Key %#v: %s", key.Interface(), message), msgAndArgs...)
+		}
+	}
+	for _, key := range actualMap.MapKeys() {
+		if !expectedMap.MapIndex(key).IsValid() {
+			return Fail(t, fmt.Sprintf("Key %#v: not expected in actual", key.Interface()), msgAndArgs...)
+		}
 	}
 
 	return true
 }
 
-// min(|expected|, |actual|) * epsilon
-func calcEpsilonDelta(expected, actual interface{}, epsilon float64) float64 {
+// relativeError returns |expected - actual| / |expected|, the error of actual
+// relative to expected.  When expected is zero, the relative error is zero if
+// actual is zero too, and +Inf otherwise.
+func relativeError(expected, actual float64) float64 {
+
+	if expected == 0 {
+		if actual == 0 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+
+	return math.Abs(expected-actual) / math.Abs(expected)
+}
+
+// inEpsilon checks that expected and actual are numbers with a relative error
+// (see relativeError) of at most epsilon.  Like with inDelta, NaN never passes,
+// and an infinity only passes with the same infinity.
+// return ("", true) if they are, or the failure message and false otherwise.
+func inEpsilon(expected, actual interface{}, epsilon float64) (string, bool) {
+
 	af, aok := toFloat(expected)
 	bf, bok := toFloat(actual)
 
 	if !aok || !bok {
-		// invalid input
-		return 0
+		return "Parameters must be numerical", false
 	}
-
-	if af < 0 {
-		af = -af
+	if math.IsNaN(epsilon) || epsilon < 0 {
+		return fmt.Sprintf("Epsilon must be zero or positive, but was %v", epsilon), false
 	}
-	if bf < 0 {
-		bf = -bf
+	if math.IsNaN(af) || math.IsNaN(bf) {
+		return fmt.Sprintf("Expected %v and %v to have a relative error of at most %v, but NaN is never close to anything", expected, actual, epsilon), false
 	}
-	var delta float64
-	if af < bf {
-		delta = af * epsilon
-	} else {
-		delta = bf * epsilon
+	if math.IsInf(af, 0) || math.IsInf(bf, 0) {
+		if af != bf {
+			return fmt.Sprintf("Expected %v and %v to have a relative error of at most %v, but an infinity is only close to the same infinity", expected, actual, epsilon), false
+		}
+		return "", true
 	}
-	return delta
+
+	if rerr := relativeError(af, bf); rerr > epsilon {
+		return fmt.Sprintf("Max relative error between %v (expected) and %v (actual) allowed is %v, but relative error was %v", expected, actual, epsilon, rerr), false
+	}
+
+	return "", true
 }
 
-// InEpsilon asserts that expected and actual have a relative error less than epsilon
+// InEpsilon asserts that expected and actual have a relative error of at most
+// epsilon, the relative error being |expected - actual| / |expected|.
+// When expected is zero, actual must be zero too.
+//
+// 	 assert.InEpsilon(t, 100, 101, 0.01)
 //
 // Returns whether the assertion was successful (true) or not (false).
 func InEpsilon(t TestingT, expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) bool {
-	delta := calcEpsilonDelta(expected, actual, epsilon)
 
-	return InDelta(t, expected, actual, delta, msgAndArgs...)
+	if message, ok := inEpsilon(expected, actual, epsilon); !ok {
+		return Fail(t, message, msgAndArgs...)
+	}
+
+	return true
+}
+
+// InEpsilonSlice asserts that the two lists (arrays or slices) have the same
+// length, and that their elements at the same index have a relative error of
+// at most epsilon, as defined by InEpsilon.
+//
+// 	 assert.InEpsilonSlice(t, []float64{100, 200}, []float64{101, 198}, 0.01)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func InEpsilonSlice(t TestingT, expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) bool {
+
+	expectedElements, actualElements, ok := numberLists(t, "InEpsilonSlice", expected, actual, msgAndArgs...)
+	if !ok {
+		return false
+	}
+
+	for i := range expectedElements {
+		if message, ok := inEpsilon(expectedElements[i], actualElements[i], epsilon); !ok {
+			return Fail(t, fmt.Sprintf("Element %d: %s", i, message), msgAndArgs...)
+		}
+	}
+
+	return true
+}
+
+// numberLists returns the elements of the expected and actual lists of an
+// assertion comparing them index by index, and fails unless both are lists
+// of the same length.
+func numberLists(t TestingT, assertion string, expected, actual interface{}, msgAndArgs ...interface{}) ([]interface{}, []interface{}, bool) {
+
+	expectedElements, ok := listElements(expected)
+	if !ok {
+		return nil, nil, Fail(t, unsupportedType(assertion, expected, "an array or slice"), msgAndArgs...)
+	}
+	actualElements, ok := listElements(actual)
+	if !ok {
+		return nil, nil, Fail(t, unsupportedType(assertion, actual, "an array or slice"), msgAndArgs...)
+	}
+	if len(expectedElements) != len(actualElements) {
+		return nil, nil, Fail(t, fmt.Sprintf("Lengths differ: %d (expected) != %d (actual)", len(expectedElements), len(actualElements)), msgAndArgs...)
+	}
+
+	return expectedElements, actualElements, true
+}
+
+// IsNaN asserts that the specified number is NaN (not a number).
+//
+// 	 assert.IsNaN(t, math.Sqrt(-1))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsNaN(t TestingT, f interface{}, msgAndArgs ...interface{}) bool {
+
+	ff, ok := toFloat(f)
+	if !ok {
+		return Fail(t, unsupportedType("IsNaN", f, "a number"), msgAndArgs...)
+	}
+	if !math.IsNaN(ff) {
+		return Fail(t, fmt.Sprintf("Expected NaN, but got: %v", f), msgAndArgs...)
+	}
+
+	return true
+}
+
+// NotNaN asserts that the specified number is not NaN (not a number).
+//
+// 	 assert.NotNaN(t, math.Sqrt(2))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotNaN(t TestingT, f interface{}, msgAndArgs ...interface{}) bool {
+
+	ff, ok := toFloat(f)
+	if !ok {
+		return Fail(t, unsupportedType("NotNaN", f, "a number"), msgAndArgs...)
+	}
+	if math.IsNaN(ff) {
+		return Fail(t, "Expected a number, but got: NaN", msgAndArgs...)
+	}
+
+	return true
 }
 
 /*
@@ -731,50 +1367,118 @@ func EqualError(t TestingT, theError error, errString string, msgAndArgs ...inte
 		s, errString, theError.Error(), message)
 }
 
+// regexpCacheSize is how many compiled regexps regexpCache holds at most.
+const regexpCacheSize = 100
+
+var (
+	// regexpCache holds the compiled regexps of the patterns given as strings
+	// to the regexp assertions, which are often run many times in loops.  It
+	// is emptied when full, so that patterns built at run time cannot grow it
+	// without bound.
+	regexpCache      = map[string]*regexp.Regexp{}
+	regexpCacheMutex sync.Mutex
+)
+
+// compileRegexp returns rx if it is a *regexp.Regexp, or the compiled regexp
+// of the pattern fmt.Sprint(rx) otherwise.
+func compileRegexp(rx interface{}) (*regexp.Regexp, error) {
+
+	if r, ok := rx.(*regexp.Regexp); ok {
+		return r, nil
+	}
+
+	pattern := fmt.Sprint(rx)
+	regexpCacheMutex.Lock()
+	defer regexpCacheMutex.Unlock()
+	if r, ok := regexpCache[pattern]; ok {
+		return r, nil
+	}
+	r, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(regexpCache) >= regexpCacheSize {
+		regexpCache = map[string]*regexp.Regexp{}
+	}
+	regexpCache[pattern] = r
+	return r, nil
+
+}
+
 // matchRegexp return true if a specified regexp matches a string.
-func matchRegexp(rx interface{}, str interface{}) bool {
+func matchRegexp(rx interface{}, str interface{}) (bool, error) {
 
-	var r *regexp.Regexp
-	if rr, ok := rx.(*regexp.Regexp); ok {
-		r = rr
-	} else {
-		r = regexp.MustCompile(fmt.Sprint(rx))
+	r, err := compileRegexp(rx)
+	if err != nil {
+		return false, err
 	}
 
-	return (r.FindStringIndex(fmt.Sprint(str)) != nil)
+	return (r.FindStringIndex(fmt.Sprint(str)) != nil), nil
 
 }
 
-// Regexp asserts that a specified regexp matches a string.
+// Regexp asserts that a specified regexp matches a string.  The regexp is
+// either a *regexp.Regexp or a pattern, compiled once and cached.
 //
 //  assert.Regexp(t, regexp.MustCompile("start"), "it's starting")
 //  assert.Regexp(t, "start...$", "it's not starting")
 //
 // Returns whether the assertion was successful (true) or not (false).
-func Regexp(t TestingT, rx interface{}, str interface{}) bool {
+func Regexp(t TestingT, rx interface{}, str interface{}, msgAndArgs ...interface{}) bool {
 
-	match := matchRegexp(rx, str)
+	match, err := matchRegexp(rx, str)
+	if err != nil {
+		return Fail(t, fmt.Sprintf("Invalid regexp \"%s\": %s", rx, err), msgAndArgs...)
+	}
 
 	if !match {
-		Fail(t, "Expect \"%s\" to match \"%s\"")
+		Fail(t, fmt.Sprintf("Expect \"%s\" to match \"%s\"", str, rx), msgAndArgs...)
 	}
 
 	return match
 }
 
-// NotRegexp asserts that a specified regexp does not match a string.
+// NotRegexp asserts that a specified regexp does not match a string.  The
+// regexp is either a *regexp.Regexp or a pattern, compiled once and cached.
 //
 //  assert.NotRegexp(t, regexp.MustCompile("starts"), "it's starting")
 //  assert.NotRegexp(t, "^start", "it's not starting")
 //
 // Returns whether the assertion was successful (true) or not (false).
-func NotRegexp(t TestingT, rx interface{}, str interface{}) bool {
-	match := matchRegexp(rx, str)
+func NotRegexp(t TestingT, rx interface{}, str interface{}, msgAndArgs ...interface{}) bool {
+
+	match, err := matchRegexp(rx, str)
+	if err != nil {
+		return Fail(t, fmt.Sprintf("Invalid regexp \"%s\": %s", rx, err), msgAndArgs...)
+	}
 
 	if match {
-		Fail(t, "Expect \"%s\" to NOT match \"%s\"")
+		Fail(t, fmt.Sprintf("Expect \"%s\" to NOT match \"%s\"", str, rx), msgAndArgs...)
 	}
 
 	return !match
 
 }
+
+// RegexpCaptures asserts that a specified regexp matches a string, and returns
+// the groups captured by its leftmost match.  The regexp is either a
+// *regexp.Regexp or a pattern, compiled once and cached.
+//
+//  assert.RegexpCaptures(t, `(\w+)@(\w+)\.com`, "bob@example.com") // []string{"bob", "example"}
+//
+// Returns the captured groups, and whether the assertion was successful (true) or not (false).
+func RegexpCaptures(t TestingT, rx interface{}, str interface{}, msgAndArgs ...interface{}) ([]string, bool) {
+
+	r, err := compileRegexp(rx)
+	if err != nil {
+		return nil, Fail(t, fmt.Sprintf("Invalid regexp \"%s\": %s", rx, err), msgAndArgs...)
+	}
+
+	match := r.FindStringSubmatch(fmt.Sprint(str))
+	if match == nil {
+		return nil, Fail(t, fmt.Sprintf("Expect \"%s\" to match \"%s\"", str, rx), msgAndArgs...)
+	}
+
+	return match[1:], true
+
+}
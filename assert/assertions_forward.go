@@ -1,7 +1,10 @@
 // auto genrated file, do not edit
 package assert
 
-import "time"
+import (
+	"os"
+	"time"
+)
 
 type Assertions struct {
 	t TestingT
@@ -13,19 +16,19 @@ func New(t TestingT) *Assertions {
 
 // Fail reports a failure through
 func (a *Assertions) Fail(failureMessage string, msgAndArgs ...interface{}) bool {
-	return Fail(a.t, failureMessage, msgAndArgs)
+	return Fail(a.t, failureMessage, msgAndArgs...)
 }
 
 // Implements asserts that an object is implemented by the specified interface.
 //
 //    assert.Implements((*MyInterface)(nil), new(MyObject), "MyObject")
 func (a *Assertions) Implements(interfaceObject interface{}, object interface{}, msgAndArgs ...interface{}) bool {
-	return Implements(a.t, interfaceObject, object, msgAndArgs)
+	return Implements(a.t, interfaceObject, object, msgAndArgs...)
 }
 
 // IsType asserts that the specified objects are of the same type.
 func (a *Assertions) IsType(expectedType interface{}, object interface{}, msgAndArgs ...interface{}) bool {
-	return IsType(a.t, expectedType, object, msgAndArgs)
+	return IsType(a.t, expectedType, object, msgAndArgs...)
 }
 
 // Equal asserts that two objects are equal.
@@ -34,7 +37,7 @@ func (a *Assertions) IsType(expectedType interface{}, object interface{}, msgAnd
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Equal(expected, actual interface{}, msgAndArgs ...interface{}) bool {
-	return Equal(a.t, expected, actual, msgAndArgs)
+	return Equal(a.t, expected, actual, msgAndArgs...)
 }
 
 // Exactly asserts that two objects are equal is value and type.
@@ -43,7 +46,27 @@ func (a *Assertions) Equal(expected, actual interface{}, msgAndArgs ...interface
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Exactly(expected, actual interface{}, msgAndArgs ...interface{}) bool {
-	return Exactly(a.t, expected, actual, msgAndArgs)
+	return Exactly(a.t, expected, actual, msgAndArgs...)
+}
+
+// Same asserts that two pointers have the same type and point to the same
+// object (i.e. hold the same address).
+//
+//    assert.Same(ptr1, ptr2, "ptr1 and ptr2 should point to the same object")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Same(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	return Same(a.t, expected, actual, msgAndArgs...)
+}
+
+// NotSame asserts that two pointers do not point to the same object (i.e. do
+// not hold the same address), or have different types.
+//
+//    assert.NotSame(ptr1, ptr2, "ptr1 and ptr2 should not point to the same object")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) NotSame(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	return NotSame(a.t, expected, actual, msgAndArgs...)
 }
 
 // NotNil asserts that the specified object is not nil.
@@ -52,7 +75,7 @@ func (a *Assertions) Exactly(expected, actual interface{}, msgAndArgs ...interfa
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) NotNil(object interface{}, msgAndArgs ...interface{}) bool {
-	return NotNil(a.t, object, msgAndArgs)
+	return NotNil(a.t, object, msgAndArgs...)
 }
 
 // Nil asserts that the specified object is nil.
@@ -61,21 +84,23 @@ func (a *Assertions) NotNil(object interface{}, msgAndArgs ...interface{}) bool
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Nil(object interface{}, msgAndArgs ...interface{}) bool {
-	return Nil(a.t, object, msgAndArgs)
+	return Nil(a.t, object, msgAndArgs...)
 }
 
-// Empty asserts that the specified object is empty.  I.e. nil, "", false, 0 or either
-// a slice or a channel with len == 0.
+// Empty asserts that the specified object is empty.  I.e. nil, "", false, 0, a
+// slice, a map or a channel with len == 0, an array or a struct whose fields are
+// all zero, or a pointer to an empty object.
 //
 // assert.Empty(obj)
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Empty(object interface{}, msgAndArgs ...interface{}) bool {
-	return Empty(a.t, object, msgAndArgs)
+	return Empty(a.t, object, msgAndArgs...)
 }
 
-// NotEmpty asserts that the specified object is NOT empty.  I.e. not nil, "", false, 0 or either
-// a slice or a channel with len == 0.
+// NotEmpty asserts that the specified object is NOT empty.  I.e. not nil, "", false,
+// 0, a slice, a map or a channel with len == 0, an array or a struct whose fields are
+// all zero, or a pointer to an empty object.
 //
 // if assert.NotEmpty(obj) {
 //   assert.Equal("two", obj[1])
@@ -83,7 +108,28 @@ func (a *Assertions) Empty(object interface{}, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) NotEmpty(object interface{}, msgAndArgs ...interface{}) bool {
-	return NotEmpty(a.t, object, msgAndArgs)
+	return NotEmpty(a.t, object, msgAndArgs...)
+}
+
+// Zero asserts that the specified object is nil or the zero value of its type.
+// Unlike with Empty, a non-nil slice, map or channel is not zero, even when it
+// has no elements, and neither is a non-nil pointer.
+//
+//    assert.Zero(obj)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Zero(object interface{}, msgAndArgs ...interface{}) bool {
+	return Zero(a.t, object, msgAndArgs...)
+}
+
+// NotZero asserts that the specified object is neither nil nor the zero value
+// of its type.
+//
+//    assert.NotZero(obj)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) NotZero(object interface{}, msgAndArgs ...interface{}) bool {
+	return NotZero(a.t, object, msgAndArgs...)
 }
 
 // Len asserts that the specified object has specific length.
@@ -93,7 +139,7 @@ func (a *Assertions) NotEmpty(object interface{}, msgAndArgs ...interface{}) boo
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Len(object interface{}, length int, msgAndArgs ...interface{}) bool {
-	return Len(a.t, object, length, msgAndArgs)
+	return Len(a.t, object, length, msgAndArgs...)
 }
 
 // True asserts that the specified value is true.
@@ -102,7 +148,7 @@ func (a *Assertions) Len(object interface{}, length int, msgAndArgs ...interface
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) True(value bool, msgAndArgs ...interface{}) bool {
-	return True(a.t, value, msgAndArgs)
+	return True(a.t, value, msgAndArgs...)
 }
 
 // False asserts that the specified value is true.
@@ -111,7 +157,7 @@ func (a *Assertions) True(value bool, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) False(value bool, msgAndArgs ...interface{}) bool {
-	return False(a.t, value, msgAndArgs)
+	return False(a.t, value, msgAndArgs...)
 }
 
 // NotEqual asserts that the specified values are NOT equal.
@@ -120,34 +166,88 @@ func (a *Assertions) False(value bool, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) NotEqual(expected, actual interface{}, msgAndArgs ...interface{}) bool {
-	return NotEqual(a.t, expected, actual, msgAndArgs)
+	return NotEqual(a.t, expected, actual, msgAndArgs...)
 }
 
-// Contains asserts that the specified string or list(array, slice...) contains the
-// specified substring or element.
+// Contains asserts that the specified string, list(array, slice...) or map contains the
+// specified substring, element or key.
 //
 //    assert.Contains("Hello World", "World", "But 'Hello World' does contain 'World'")
 //    assert.Contains(["Hello", "World"], "World", "But ["Hello", "World"] does contain 'World'")
+//    assert.Contains({"Hello": "World"}, "Hello", "But {'Hello': 'World'} does contain 'Hello'")
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Contains(s, contains interface{}, msgAndArgs ...interface{}) bool {
-	return Contains(a.t, s, contains, msgAndArgs)
+	return Contains(a.t, s, contains, msgAndArgs...)
 }
 
-// NotContains asserts that the specified string or list(array, slice...) does NOT contain the
-// specified substring or element.
+// NotContains asserts that the specified string, list(array, slice...) or map does NOT contain the
+// specified substring, element or key.
 //
 //    assert.NotContains("Hello World", "Earth", "But 'Hello World' does NOT contain 'Earth'")
 //    assert.NotContains(["Hello", "World"], "Earth", "But ['Hello', 'World'] does NOT contain 'Earth'")
+//    assert.NotContains({"Hello": "World"}, "Earth", "But {'Hello': 'World'} does NOT contain 'Earth'")
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) NotContains(s, contains interface{}, msgAndArgs ...interface{}) bool {
-	return NotContains(a.t, s, contains, msgAndArgs)
+	return NotContains(a.t, s, contains, msgAndArgs...)
+}
+
+// ContainsKey asserts that the specified map has the specified key.
+//
+//    assert.ContainsKey({"Hello": "World"}, "Hello", "But {'Hello': 'World'} does have the key 'Hello'")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) ContainsKey(m, key interface{}, msgAndArgs ...interface{}) bool {
+	return ContainsKey(a.t, m, key, msgAndArgs...)
+}
+
+// ContainsValue asserts that the specified map has the specified value for some key.
+//
+//    assert.ContainsValue({"Hello": "World"}, "World", "But {'Hello': 'World'} does have the value 'World'")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) ContainsValue(m, value interface{}, msgAndArgs ...interface{}) bool {
+	return ContainsValue(a.t, m, value, msgAndArgs...)
+}
+
+// ElementsMatch asserts that the specified lists (arrays or slices) contain
+// the same elements, regardless of their order.  Duplicates are counted, so
+// each element must appear the same number of times in both lists.
+//
+//    assert.ElementsMatch([]int{1, 3, 2, 3}, []int{3, 3, 1, 2}, "The lists should have the same elements")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) ElementsMatch(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	return ElementsMatch(a.t, expected, actual, msgAndArgs...)
+}
+
+// Subset asserts that every element of subset is contained in list, when
+// both are lists (arrays or slices), or that every entry of subset is in
+// list with an equal value, when both are maps.  Duplicates are not counted.
+//
+//    assert.Subset([]int{1, 2, 3}, []int{3, 1}, "1 and 3 should be in the list")
+//    assert.Subset(map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Subset(list, subset interface{}, msgAndArgs ...interface{}) bool {
+	return Subset(a.t, list, subset, msgAndArgs...)
+}
+
+// NotSubset asserts that some element of subset is not contained in list,
+// when both are lists (arrays or slices), or that some entry of subset is
+// not in list with an equal value, when both are maps.
+//
+//    assert.NotSubset([]int{1, 2, 3}, []int{4, 1}, "4 should not be in the list")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) NotSubset(list, subset interface{}, msgAndArgs ...interface{}) bool {
+	return NotSubset(a.t, list, subset, msgAndArgs...)
 }
 
 // Condition uses a Comparison to assert a complex condition.
 func (a *Assertions) Condition(comp Comparison, msgAndArgs ...interface{}) bool {
-	return Condition(a.t, comp, msgAndArgs)
+	return Condition(a.t, comp, msgAndArgs...)
 }
 
 // Panics asserts that the code inside the specified PanicTestFunc panics.
@@ -158,7 +258,36 @@ func (a *Assertions) Condition(comp Comparison, msgAndArgs ...interface{}) bool
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Panics(f PanicTestFunc, msgAndArgs ...interface{}) bool {
-	return Panics(a.t, f, msgAndArgs)
+	return Panics(a.t, f, msgAndArgs...)
+}
+
+// PanicsWithValue asserts that the code inside the specified PanicTestFunc panics,
+// and that the recovered panic value equals the expected value.
+//
+//   assert.PanicsWithValue("crazy error", func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with \"crazy error\"")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) PanicsWithValue(expected interface{}, f PanicTestFunc, msgAndArgs ...interface{}) bool {
+	return PanicsWithValue(a.t, expected, f, msgAndArgs...)
+}
+
+// PanicsWithError asserts that the code inside the specified PanicTestFunc panics
+// with an error.  When expected is a string, the message of the error must equal
+// it; when expected is an error, the error must match it, as errors.Is does
+// through the chain of wrapped errors.
+//
+//   assert.PanicsWithError("crazy error", func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with the \"crazy error\" error")
+//   assert.PanicsWithError(ErrCrazy, func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with ErrCrazy")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) PanicsWithError(expected interface{}, f PanicTestFunc, msgAndArgs ...interface{}) bool {
+	return PanicsWithError(a.t, expected, f, msgAndArgs...)
 }
 
 // NotPanics asserts that the code inside the specified PanicTestFunc does NOT panic.
@@ -169,7 +298,7 @@ func (a *Assertions) Panics(f PanicTestFunc, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) NotPanics(f PanicTestFunc, msgAndArgs ...interface{}) bool {
-	return NotPanics(a.t, f, msgAndArgs)
+	return NotPanics(a.t, f, msgAndArgs...)
 }
 
 // WithinDuration asserts that the two times are within duration delta of each other.
@@ -178,23 +307,79 @@ func (a *Assertions) NotPanics(f PanicTestFunc, msgAndArgs ...interface{}) bool
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) WithinDuration(expected, actual time.Time, delta time.Duration, msgAndArgs ...interface{}) bool {
-	return WithinDuration(a.t, expected, actual, delta, msgAndArgs)
+	return WithinDuration(a.t, expected, actual, delta, msgAndArgs...)
 }
 
 // InDelta asserts that the two numerals are within delta of each other.
+// NaN is not within any delta of anything, and an infinity is only within
+// delta of the same infinity.
 //
 // 	 assert.InDelta(math.Pi, (22 / 7.0), 0.01)
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) InDelta(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
-	return InDelta(a.t, expected, actual, delta, msgAndArgs)
+	return InDelta(a.t, expected, actual, delta, msgAndArgs...)
+}
+
+// InDeltaSlice asserts that the two lists (arrays or slices) have the same
+// length, and that their elements at the same index are within delta of
+// each other.
+//
+// 	 assert.InDeltaSlice([]float64{math.Pi, math.E}, []float64{3.14, 2.72}, 0.01)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) InDeltaSlice(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
+	return InDeltaSlice(a.t, expected, actual, delta, msgAndArgs...)
+}
+
+// InDeltaMapValues asserts that the two maps have the same keys, and that
+// their values for the same key are within delta of each other.
+//
+// 	 assert.InDeltaMapValues(map[string]float64{"pi": math.Pi}, map[string]float64{"pi": 3.14}, 0.01)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) InDeltaMapValues(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
+	return InDeltaMapValues(a.t, expected, actual, delta, msgAndArgs...)
 }
 
-// InEpsilon asserts that expected and actual have a relative error less than epsilon
+// InEpsilon asserts that expected and actual have a relative error of at most
+// epsilon, the relative error being |expected - actual| / |expected|.
+// When expected is zero, actual must be zero too.
+//
+// 	 assert.InEpsilon(100, 101, 0.01)
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) InEpsilon(expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) bool {
-	return InEpsilon(a.t, expected, actual, epsilon, msgAndArgs)
+	return InEpsilon(a.t, expected, actual, epsilon, msgAndArgs...)
+}
+
+// InEpsilonSlice asserts that the two lists (arrays or slices) have the same
+// length, and that their elements at the same index have a relative error of
+// at most epsilon, as defined by InEpsilon.
+//
+// 	 assert.InEpsilonSlice([]float64{100, 200}, []float64{101, 198}, 0.01)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) InEpsilonSlice(expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) bool {
+	return InEpsilonSlice(a.t, expected, actual, epsilon, msgAndArgs...)
+}
+
+// IsNaN asserts that the specified number is NaN (not a number).
+//
+// 	 assert.IsNaN(math.Sqrt(-1))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) IsNaN(f interface{}, msgAndArgs ...interface{}) bool {
+	return IsNaN(a.t, f, msgAndArgs...)
+}
+
+// NotNaN asserts that the specified number is not NaN (not a number).
+//
+// 	 assert.NotNaN(math.Sqrt(2))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) NotNaN(f interface{}, msgAndArgs ...interface{}) bool {
+	return NotNaN(a.t, f, msgAndArgs...)
 }
 
 // NoError asserts that a function returned no error (i.e. `nil`).
@@ -206,7 +391,7 @@ func (a *Assertions) InEpsilon(expected, actual interface{}, epsilon float64, ms
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) NoError(err error, msgAndArgs ...interface{}) bool {
-	return NoError(a.t, err, msgAndArgs)
+	return NoError(a.t, err, msgAndArgs...)
 }
 
 // Error asserts that a function returned an error (i.e. not `nil`).
@@ -218,7 +403,7 @@ func (a *Assertions) NoError(err error, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Error(err error, msgAndArgs ...interface{}) bool {
-	return Error(a.t, err, msgAndArgs)
+	return Error(a.t, err, msgAndArgs...)
 }
 
 // EqualError asserts that a function returned an error (i.e. not `nil`)
@@ -231,25 +416,248 @@ func (a *Assertions) Error(err error, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) EqualError(theError error, errString string, msgAndArgs ...interface{}) bool {
-	return EqualError(a.t, theError, errString, msgAndArgs)
+	return EqualError(a.t, theError, errString, msgAndArgs...)
 }
 
-// Regexp asserts that a specified regexp matches a string.
+// Regexp asserts that a specified regexp matches a string.  The regexp is
+// either a *regexp.Regexp or a pattern, compiled once and cached.
 //
 //  assert.Regexp(regexp.MustCompile("start"), "it's starting")
 //  assert.Regexp("start...$", "it's not starting")
 //
 // Returns whether the assertion was successful (true) or not (false).
-func (a *Assertions) Regexp(rx interface{}, str interface{}) bool {
-	return Regexp(a.t, rx, str)
+func (a *Assertions) Regexp(rx interface{}, str interface{}, msgAndArgs ...interface{}) bool {
+	return Regexp(a.t, rx, str, msgAndArgs...)
 }
 
-// NotRegexp asserts that a specified regexp does not match a string.
+// NotRegexp asserts that a specified regexp does not match a string.  The
+// regexp is either a *regexp.Regexp or a pattern, compiled once and cached.
 //
 //  assert.NotRegexp(regexp.MustCompile("starts"), "it's starting")
 //  assert.NotRegexp("^start", "it's not starting")
 //
 // Returns whether the assertion was successful (true) or not (false).
-func (a *Assertions) NotRegexp(rx interface{}, str interface{}) bool {
-	return NotRegexp(a.t, rx, str)
+func (a *Assertions) NotRegexp(rx interface{}, str interface{}, msgAndArgs ...interface{}) bool {
+	return NotRegexp(a.t, rx, str, msgAndArgs...)
+}
+
+// RegexpCaptures asserts that a specified regexp matches a string, and returns
+// the groups captured by its leftmost match.  The regexp is either a
+// *regexp.Regexp or a pattern, compiled once and cached.
+//
+//  assert.RegexpCaptures(`(\w+)@(\w+)\.com`, "bob@example.com") // []string{"bob", "example"}
+//
+// Returns the captured groups, and whether the assertion was successful (true) or not (false).
+func (a *Assertions) RegexpCaptures(rx interface{}, str interface{}, msgAndArgs ...interface{}) ([]string, bool) {
+	return RegexpCaptures(a.t, rx, str, msgAndArgs...)
+}
+
+// Greater asserts that the first element is greater than the second.
+//
+//	assert.Greater(2, 1)
+//	assert.Greater(float64(2), float32(1))
+//	assert.Greater("b", "a")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Greater(e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return Greater(a.t, e1, e2, msgAndArgs...)
+}
+
+// GreaterOrEqual asserts that the first element is greater than or equal to the second.
+//
+//	assert.GreaterOrEqual(2, 1)
+//	assert.GreaterOrEqual(2, 2)
+//	assert.GreaterOrEqual("b", "a")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) GreaterOrEqual(e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return GreaterOrEqual(a.t, e1, e2, msgAndArgs...)
+}
+
+// Less asserts that the first element is less than the second.
+//
+//	assert.Less(1, 2)
+//	assert.Less(float64(1), float32(2))
+//	assert.Less("a", "b")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Less(e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return Less(a.t, e1, e2, msgAndArgs...)
+}
+
+// LessOrEqual asserts that the first element is less than or equal to the second.
+//
+//	assert.LessOrEqual(1, 2)
+//	assert.LessOrEqual(2, 2)
+//	assert.LessOrEqual("a", "b")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) LessOrEqual(e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return LessOrEqual(a.t, e1, e2, msgAndArgs...)
+}
+
+// Positive asserts that the specified number is greater than zero.
+//
+//	assert.Positive(1)
+//	assert.Positive(time.Second)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Positive(e interface{}, msgAndArgs ...interface{}) bool {
+	return Positive(a.t, e, msgAndArgs...)
+}
+
+// Negative asserts that the specified number is less than zero.
+//
+//	assert.Negative(-1)
+//	assert.Negative(-time.Second)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Negative(e interface{}, msgAndArgs ...interface{}) bool {
+	return Negative(a.t, e, msgAndArgs...)
+}
+
+// IsIncreasing asserts that each element of the list is greater than the previous one.
+//
+//	assert.IsIncreasing([]int{1, 2, 3})
+//	assert.IsIncreasing([]string{"a", "b"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) IsIncreasing(list interface{}, msgAndArgs ...interface{}) bool {
+	return IsIncreasing(a.t, list, msgAndArgs...)
+}
+
+// IsNonIncreasing asserts that each element of the list is less than or equal to the previous one.
+//
+//	assert.IsNonIncreasing([]int{2, 1, 1})
+//	assert.IsNonIncreasing([]string{"b", "a"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) IsNonIncreasing(list interface{}, msgAndArgs ...interface{}) bool {
+	return IsNonIncreasing(a.t, list, msgAndArgs...)
+}
+
+// IsDecreasing asserts that each element of the list is less than the previous one.
+//
+//	assert.IsDecreasing([]int{3, 2, 1})
+//	assert.IsDecreasing([]string{"b", "a"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) IsDecreasing(list interface{}, msgAndArgs ...interface{}) bool {
+	return IsDecreasing(a.t, list, msgAndArgs...)
+}
+
+// IsNonDecreasing asserts that each element of the list is greater than or equal to the previous one.
+//
+//	assert.IsNonDecreasing([]int{1, 1, 2})
+//	assert.IsNonDecreasing([]string{"a", "b"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) IsNonDecreasing(list interface{}, msgAndArgs ...interface{}) bool {
+	return IsNonDecreasing(a.t, list, msgAndArgs...)
+}
+
+// Sorted asserts that the list (array or slice) is sorted according to less,
+// which reports whether the element at index i must sort before the element
+// at index j, as with sort.Slice.
+//
+//	assert.Sorted(people, func(i, j int) bool { return people[i].Age < people[j].Age })
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Sorted(list interface{}, less LessFunc, msgAndArgs ...interface{}) bool {
+	return Sorted(a.t, list, less, msgAndArgs...)
+}
+
+// FileExists asserts that the specified path exists and is not a directory.
+// Symbolic links are followed.
+//
+//	assert.FileExists("testdata/config.json")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) FileExists(path string, msgAndArgs ...interface{}) bool {
+	return FileExists(a.t, path, msgAndArgs...)
+}
+
+// NoFileExists asserts that the specified path does not exist, or is a
+// directory.  Symbolic links are followed.
+//
+//	assert.NoFileExists("testdata/output.json")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) NoFileExists(path string, msgAndArgs ...interface{}) bool {
+	return NoFileExists(a.t, path, msgAndArgs...)
+}
+
+// DirExists asserts that the specified path exists and is a directory.
+// Symbolic links are followed.
+//
+//	assert.DirExists("testdata")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) DirExists(path string, msgAndArgs ...interface{}) bool {
+	return DirExists(a.t, path, msgAndArgs...)
+}
+
+// NoDirExists asserts that the specified path does not exist, or is not a
+// directory.  Symbolic links are followed.
+//
+//	assert.NoDirExists("testdata/tmp")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) NoDirExists(path string, msgAndArgs ...interface{}) bool {
+	return NoDirExists(a.t, path, msgAndArgs...)
+}
+
+// FileContains asserts that the content of the file at the specified path
+// contains the specified substring.
+//
+//	assert.FileContains("testdata/config.json", `"debug": true`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) FileContains(path string, contains string, msgAndArgs ...interface{}) bool {
+	return FileContains(a.t, path, contains, msgAndArgs...)
+}
+
+// FileEquals asserts that the content of the file at the specified path is
+// equal to expected, a string or a []byte, and shows how they differ when
+// it is not.
+//
+//	assert.FileEquals("out/report.txt", "total: 3\n")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) FileEquals(path string, expected interface{}, msgAndArgs ...interface{}) bool {
+	return FileEquals(a.t, path, expected, msgAndArgs...)
+}
+
+// FileMode asserts that the file or directory at the specified path has the
+// specified permission bits.  The type bits of the file, such as os.ModeDir,
+// are only compared when mode has some.  Symbolic links are followed.
+//
+//	assert.FileMode("bin/run.sh", 0755)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) FileMode(path string, mode os.FileMode, msgAndArgs ...interface{}) bool {
+	return FileMode(a.t, path, mode, msgAndArgs...)
+}
+
+// Golden asserts that actual, a string or a []byte, is equal to the
+// content of the golden file testdata/<name>.golden.  When the tests
+// are run with the -testify.update flag, the golden file is written
+// with actual instead.
+//
+//	assert.Golden("render/index.html", renderIndex())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Golden(name string, actual interface{}, msgAndArgs ...interface{}) bool {
+	return Golden(a.t, name, actual, msgAndArgs...)
+}
+
+// GoldenText asserts like Golden that actual is equal to the content
+// of the golden file testdata/<name>.golden, but ignores differences
+// between "\r\n", "\r" and "\n" line endings.
+//
+//	assert.GoldenText("report.txt", report.String())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) GoldenText(name string, actual interface{}, msgAndArgs ...interface{}) bool {
+	return GoldenText(a.t, name, actual, msgAndArgs...)
 }
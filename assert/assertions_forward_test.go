@@ -0,0 +1,30 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// messageT keeps the last failure reported to it.
+type messageT struct {
+	message string
+}
+
+func (t *messageT) Errorf(format string, args ...interface{}) {
+	t.message = fmt.Sprintf(format, args...)
+}
+
+func TestForwardedMessageArgs(t *testing.T) {
+
+	mockT := new(messageT)
+	assert := New(mockT)
+
+	if assert.Equal(1, 2, "msg %d", 1) {
+		t.Error("Equal should return false")
+	}
+	if !strings.Contains(mockT.message, "Messages:\tmsg 1") {
+		t.Errorf("Expected the message to be formatted with its arguments, got %q", mockT.message)
+	}
+
+}
@@ -1,7 +1,10 @@
 package assert
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"math"
 	"regexp"
 	"testing"
 	"time"
@@ -23,6 +26,16 @@ func (a *AssertionTesterConformingObject) TestMethod() {
 type AssertionTesterNonConformingObject struct {
 }
 
+// bufferT is a TestingT that keeps the failures reported to it, so that
+// tests can check the failure messages of assertions.
+type bufferT struct {
+	buf bytes.Buffer
+}
+
+func (t *bufferT) Errorf(format string, args ...interface{}) {
+	fmt.Fprintf(&t.buf, format, args...)
+}
+
 func TestObjectsAreEqual(t *testing.T) {
 
 	if !ObjectsAreEqual("Hello World", "Hello World") {
@@ -43,6 +56,23 @@ func TestObjectsAreEqual(t *testing.T) {
 
 }
 
+func TestIsTestifyFrame(t *testing.T) {
+
+	True(t, isTestifyFrame("github.com/stretchr/testify/assert.Equal", "assert", "assertions.go"))
+	True(t, isTestifyFrame("github.com/stretchr/testify/assert.TestEqual", "assert", "assertions_test.go"))
+	True(t, isTestifyFrame("github.com/stretchr/testify/require.Equal", "require", "requirements.go"))
+	True(t, isTestifyFrame("github.com/stretchr/testify/mock.(*Mock).Called", "mock", "mock.go"))
+	True(t, isTestifyFrame("github.com/stretchr/testify/suite.(*Suite).GoldenFile", "suite", "suite.go"))
+	True(t, isTestifyFrame("myapp/vendor/github.com/stretchr/testify/suite.(*Suite).GoldenFile", "suite", "suite.go"))
+	False(t, isTestifyFrame("github.com/stretchr/testify/mock.TestCalled", "mock", "mock_test.go"))
+	False(t, isTestifyFrame("github.com/stretchr/testify/suite.(*SuiteTester).TestOne", "suite", "suite_test.go"))
+	False(t, isTestifyFrame("myapp.TestApp", "myapp", "myapp_test.go"))
+
+	// Packages of other projects called suite are not testify's.
+	False(t, isTestifyFrame("myapp/internal/suite.Check", "suite", "helpers.go"))
+
+}
+
 func TestImplements(t *testing.T) {
 
 	mockT := new(testing.T)
@@ -97,6 +127,55 @@ func TestEqual(t *testing.T) {
 
 }
 
+func TestSame(t *testing.T) {
+
+	mockT := new(testing.T)
+	a, b := &A{"a", "b"}, &A{"a", "b"}
+
+	if !Same(mockT, a, a) {
+		t.Error("Same should return true: a and a are the same pointer")
+	}
+	if Same(mockT, a, b) {
+		t.Error("Same should return false: a and b point to different objects")
+	}
+	if Same(mockT, *a, *a) {
+		t.Error("Same should return false: *a is not a pointer")
+	}
+	if Same(mockT, a, nil) {
+		t.Error("Same should return false: nil is not a pointer")
+	}
+	if !Same(mockT, (*A)(nil), (*A)(nil)) {
+		t.Error("Same should return true: two nil pointers of the same type are the same")
+	}
+
+	bufT := new(bufferT)
+	Same(bufT, a, b)
+	Contains(t, bufT.buf.String(), fmt.Sprintf("Not same: %p (assert.A{Name:\"a\", Value:\"b\"}) (expected)", a))
+	Contains(t, bufT.buf.String(), fmt.Sprintf("!= %p (assert.A{Name:\"a\", Value:\"b\"}) (actual)", b))
+
+}
+
+func TestNotSame(t *testing.T) {
+
+	mockT := new(testing.T)
+	a, b := &A{"a", "b"}, &A{"a", "b"}
+
+	if !NotSame(mockT, a, b) {
+		t.Error("NotSame should return true: a and b point to different objects")
+	}
+	if NotSame(mockT, a, a) {
+		t.Error("NotSame should return false: a and a are the same pointer")
+	}
+	if NotSame(mockT, 1, 2) {
+		t.Error("NotSame should return false: 1 and 2 are not pointers")
+	}
+
+	bufT := new(bufferT)
+	NotSame(bufT, (*A)(nil), (*A)(nil))
+	Contains(t, bufT.buf.String(), "Should not be same: 0x0 (nil) (expected)")
+
+}
+
 func TestNotNil(t *testing.T) {
 
 	mockT := new(testing.T)
@@ -248,6 +327,18 @@ func TestContains(t *testing.T) {
 	if Contains(mockT, complexList, &A{"g", "e"}) {
 		t.Error("Contains should return false: complexList contains {\"g\", \"e\"}")
 	}
+	if !Contains(mockT, map[string]int{"Foo": 1}, "Foo") {
+		t.Error("Contains should return true: {\"Foo\": 1} has the key \"Foo\"")
+	}
+	if Contains(mockT, map[string]int{"Foo": 1}, 1) {
+		t.Error("Contains should return false: {\"Foo\": 1} does not have the key 1")
+	}
+
+	bufT := new(bufferT)
+	if Contains(bufT, 1433, 1) {
+		t.Error("Contains should return false: 1433 is not a string, list or map")
+	}
+	Contains(t, bufT.buf.String(), "Contains cannot be applied to 1433: unsupported type int, expected a string, array, slice or map")
 }
 
 func TestNotContains(t *testing.T) {
@@ -268,6 +359,133 @@ func TestNotContains(t *testing.T) {
 	if NotContains(mockT, list, "Foo") {
 		t.Error("NotContains should return false: \"[\"Foo\", \"Bar\"]\" contains \"Foo\"")
 	}
+	if NotContains(mockT, map[string]int{"Foo": 1}, "Foo") {
+		t.Error("NotContains should return false: {\"Foo\": 1} has the key \"Foo\"")
+	}
+	if NotContains(mockT, nil, "Foo") {
+		t.Error("NotContains should return false: nil is not a string, list or map")
+	}
+
+}
+
+func TestContainsKey(t *testing.T) {
+
+	mockT := new(testing.T)
+	m := map[string]int{"Foo": 1, "Bar": 2}
+
+	if !ContainsKey(mockT, m, "Foo") {
+		t.Error("ContainsKey should return true: the map has the key \"Foo\"")
+	}
+	if ContainsKey(mockT, m, "Baz") {
+		t.Error("ContainsKey should return false: the map does not have the key \"Baz\"")
+	}
+	if ContainsKey(mockT, []string{"Foo"}, "Foo") {
+		t.Error("ContainsKey should return false: a slice is not a map")
+	}
+
+}
+
+func TestContainsValue(t *testing.T) {
+
+	mockT := new(testing.T)
+	m := map[string]int{"Foo": 1, "Bar": 2}
+
+	if !ContainsValue(mockT, m, 2) {
+		t.Error("ContainsValue should return true: the map has the value 2")
+	}
+	if ContainsValue(mockT, m, "Foo") {
+		t.Error("ContainsValue should return false: the map does not have the value \"Foo\"")
+	}
+
+	bufT := new(bufferT)
+	if ContainsValue(bufT, "Foo", "F") {
+		t.Error("ContainsValue should return false: a string is not a map")
+	}
+	Contains(t, bufT.buf.String(), `ContainsValue cannot be applied to "Foo": unsupported type string, expected a map`)
+
+}
+
+func TestElementsMatch(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !ElementsMatch(mockT, []int{1, 3, 2, 3}, []int{3, 3, 1, 2}) {
+		t.Error("ElementsMatch should return true: the lists have the same elements")
+	}
+	if !ElementsMatch(mockT, [2]string{"Foo", "Bar"}, []string{"Bar", "Foo"}) {
+		t.Error("ElementsMatch should return true: arrays and slices can be compared")
+	}
+	if !ElementsMatch(mockT, nil, []int{}) {
+		t.Error("ElementsMatch should return true: nil is an empty list")
+	}
+	if ElementsMatch(mockT, []int{1, 2, 2}, []int{1, 1, 2}) {
+		t.Error("ElementsMatch should return false: the duplicates differ")
+	}
+	if ElementsMatch(mockT, "Foo", "Foo") {
+		t.Error("ElementsMatch should return false: strings are not lists")
+	}
+
+	bufT := new(bufferT)
+	ElementsMatch(bufT, []int{1, 2, 2, 3}, []int{4, 2, 1, 1})
+	Contains(t, bufT.buf.String(), "missing from actual: []interface {}{2, 3}")
+	Contains(t, bufT.buf.String(), "extra in actual: []interface {}{4, 1}")
+
+}
+
+func TestSubset(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !Subset(mockT, []int{1, 2, 3}, []int{3, 1}) {
+		t.Error("Subset should return true: 1 and 3 are in the list")
+	}
+	if !Subset(mockT, []int{1, 2, 3}, nil) {
+		t.Error("Subset should return true: nil is an empty list")
+	}
+	if !Subset(mockT, [3]string{"Foo", "Bar", "Baz"}, []string{"Baz", "Baz"}) {
+		t.Error("Subset should return true: duplicates are not counted")
+	}
+	if Subset(mockT, []int{1, 2, 3}, []int{4, 1}) {
+		t.Error("Subset should return false: 4 is not in the list")
+	}
+	if !Subset(mockT, map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1}) {
+		t.Error("Subset should return true: the entry a is in the map")
+	}
+	if Subset(mockT, map[string]int{"a": 1, "b": 2}, map[string]int{"a": 2}) {
+		t.Error("Subset should return false: the entry a has another value")
+	}
+	if Subset(mockT, map[string]int{"a": 1}, []string{"a"}) {
+		t.Error("Subset should return false: a map and a list cannot be compared")
+	}
+	if Subset(mockT, map[string]int{"a": 1}, map[int]int{1: 1}) {
+		t.Error("Subset should return false: the maps have different types of keys")
+	}
+
+	bufT := new(bufferT)
+	Subset(bufT, map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "b": 3, "c": 4})
+	Contains(t, bufT.buf.String(), `missing: map[string]int{"b":3, "c":4}`)
+
+}
+
+func TestNotSubset(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !NotSubset(mockT, []int{1, 2, 3}, []int{4, 1}) {
+		t.Error("NotSubset should return true: 4 is not in the list")
+	}
+	if NotSubset(mockT, []int{1, 2, 3}, []int{3, 1}) {
+		t.Error("NotSubset should return false: 1 and 3 are in the list")
+	}
+	if !NotSubset(mockT, map[string]int{"a": 1, "b": 2}, map[string]int{"c": 3}) {
+		t.Error("NotSubset should return true: the entry c is not in the map")
+	}
+	if NotSubset(mockT, map[string]int{"a": 1, "b": 2}, map[string]int{}) {
+		t.Error("NotSubset should return false: an empty map is a subset of any map")
+	}
+	if NotSubset(mockT, 1, []int{1}) {
+		t.Error("NotSubset should return false: 1 is not a list")
+	}
 
 }
 
@@ -312,6 +530,18 @@ func Test_includeElement(t *testing.T) {
 	False(t, ok)
 	False(t, found)
 
+	ok, found = includeElement(map[string]int{"Foo": 1}, "Foo")
+	True(t, ok)
+	True(t, found)
+
+	ok, found = includeElement(map[string]int{"Foo": 1}, 1)
+	True(t, ok)
+	False(t, found)
+
+	ok, found = includeElement(nil, "Foo")
+	False(t, ok)
+	False(t, found)
+
 }
 
 func TestCondition(t *testing.T) {
@@ -329,17 +559,24 @@ func TestCondition(t *testing.T) {
 
 func TestDidPanic(t *testing.T) {
 
-	if funcDidPanic, _ := didPanic(func() {
+	if funcDidPanic, _, _ := didPanic(func() {
 		panic("Panic!")
 	}); !funcDidPanic {
 		t.Error("didPanic should return true")
 	}
 
-	if funcDidPanic, _ := didPanic(func() {
+	if funcDidPanic, _, _ := didPanic(func() {
 	}); funcDidPanic {
 		t.Error("didPanic should return false")
 	}
 
+	funcDidPanic, panicValue, panicStack := didPanic(func() {
+		panic(AnError)
+	})
+	True(t, funcDidPanic)
+	Equal(t, AnError, panicValue)
+	Contains(t, panicStack, "TestDidPanic")
+
 }
 
 func TestPanics(t *testing.T) {
@@ -374,6 +611,90 @@ func TestNotPanics(t *testing.T) {
 		t.Error("NotPanics should return false")
 	}
 
+	bufT := new(bufferT)
+	NotPanics(bufT, func() {
+		panic("Panic!")
+	})
+	Contains(t, bufT.buf.String(), "assert.TestNotPanics.func")
+	Contains(t, bufT.buf.String(), "Panic value:\t\"Panic!\"")
+	Contains(t, bufT.buf.String(), "Panic stack:")
+	Contains(t, bufT.buf.String(), "assertions_test.go")
+
+}
+
+func TestPanicsWithValue(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !PanicsWithValue(mockT, "Panic!", func() {
+		panic("Panic!")
+	}) {
+		t.Error("PanicsWithValue should return true")
+	}
+
+	if PanicsWithValue(mockT, "Panic!", func() {
+	}) {
+		t.Error("PanicsWithValue should return false")
+	}
+
+	bufT := new(bufferT)
+	if PanicsWithValue(bufT, "Panic!", func() {
+		panic("at the disco")
+	}) {
+		t.Error("PanicsWithValue should return false")
+	}
+	Contains(t, bufT.buf.String(), "should panic with value:\t\"Panic!\"")
+	Contains(t, bufT.buf.String(), "Panic value:\t\"at the disco\"")
+	Contains(t, bufT.buf.String(), "Panic stack:")
+
+}
+
+func TestPanicsWithError(t *testing.T) {
+
+	mockT := new(testing.T)
+	errPanic := errors.New("panic")
+
+	if !PanicsWithError(mockT, "panic", func() {
+		panic(errPanic)
+	}) {
+		t.Error("PanicsWithError should return true: the error message matches")
+	}
+
+	if !PanicsWithError(mockT, errPanic, func() {
+		panic(fmt.Errorf("wrapped: %w", errPanic))
+	}) {
+		t.Error("PanicsWithError should return true: the error chain contains errPanic")
+	}
+
+	if PanicsWithError(mockT, "panic", func() {
+		panic(fmt.Errorf("wrapped: %w", errPanic))
+	}) {
+		t.Error("PanicsWithError should return false: the error message differs")
+	}
+
+	if PanicsWithError(mockT, errPanic, func() {
+		panic(errors.New("panic"))
+	}) {
+		t.Error("PanicsWithError should return false: the error chain does not contain errPanic")
+	}
+
+	if PanicsWithError(mockT, "panic", func() {
+		panic("panic")
+	}) {
+		t.Error("PanicsWithError should return false: the panic value is not an error")
+	}
+
+	if PanicsWithError(mockT, "panic", func() {
+	}) {
+		t.Error("PanicsWithError should return false: the function does not panic")
+	}
+
+	if PanicsWithError(mockT, 1, func() {
+		panic(errPanic)
+	}) {
+		t.Error("PanicsWithError should return false: 1 is not a string or an error")
+	}
+
 }
 
 func TestEqual_Funcs(t *testing.T) {
@@ -460,6 +781,47 @@ func Test_isEmpty(t *testing.T) {
 	False(t, isEmpty(map[string]string{"Hello": "World"}))
 	False(t, isEmpty(chWithValue))
 
+	// Arrays, structs and pointers are empty when they hold zero values.
+	zero := 0
+	one := 1
+	True(t, isEmpty([2]int{}))
+	True(t, isEmpty(A{}))
+	True(t, isEmpty(time.Time{}))
+	True(t, isEmpty(&zero))
+	True(t, isEmpty(&A{}))
+	True(t, isEmpty((*A)(nil)))
+	False(t, isEmpty([2]int{0, 1}))
+	False(t, isEmpty(A{Name: "something"}))
+	False(t, isEmpty(time.Now()))
+	False(t, isEmpty(&one))
+	False(t, isEmpty(&[]string{"something"}))
+
+}
+
+func TestZero(t *testing.T) {
+
+	mockT := new(testing.T)
+	var nilSlice []string
+	var nilFunc func()
+
+	for _, zero := range []interface{}{
+		nil, "", 0, int8(0), uint64(0), 0.0, float32(0), complex64(0), false,
+		nilSlice, map[string]int(nil), (chan int)(nil), nilFunc, (*A)(nil), error(nil),
+		[2]int{}, A{}, time.Time{}, struct{}{},
+	} {
+		True(t, Zero(mockT, zero), "%#v should be zero", zero)
+		False(t, NotZero(mockT, zero), "%#v should be zero", zero)
+	}
+
+	for _, notZero := range []interface{}{
+		"something", 1, int8(-1), uint64(1), 0.1, complex64(1i), true,
+		[]string{}, map[string]int{}, make(chan int), func() {}, &A{}, errors.New(""),
+		[2]int{0, 1}, A{Value: "something"}, time.Now(),
+	} {
+		False(t, Zero(mockT, notZero), "%#v should not be zero", notZero)
+		True(t, NotZero(mockT, notZero), "%#v should not be zero", notZero)
+	}
+
 }
 
 func TestEmpty(t *testing.T) {
@@ -703,6 +1065,99 @@ func TestInEpsilon(t *testing.T) {
 
 }
 
+func TestInDeltaNaNAndInf(t *testing.T) {
+	mockT := new(testing.T)
+
+	False(t, InDelta(mockT, math.NaN(), math.NaN(), 1), "Expected NaN to fail")
+	False(t, InDelta(mockT, 1, math.NaN(), 1), "Expected NaN to fail")
+	False(t, InDelta(mockT, 1, 1, math.NaN()), "Expected a NaN delta to fail")
+	False(t, InDelta(mockT, 1, 1, -1), "Expected a negative delta to fail")
+	True(t, InDelta(mockT, math.Inf(1), math.Inf(1), 1), "Expected +Inf to be within delta of +Inf")
+	False(t, InDelta(mockT, math.Inf(1), math.Inf(-1), 1), "Expected +Inf not to be within delta of -Inf")
+	False(t, InDelta(mockT, math.Inf(1), math.MaxFloat64, math.Inf(1)), "Expected +Inf not to be within delta of a number")
+}
+
+func TestInDeltaSlice(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, InDeltaSlice(mockT, []float64{1.001, 0.999}, []float64{1, 1}, 0.01), "|1.001 - 1| <= 0.01 and |0.999 - 1| <= 0.01")
+	True(t, InDeltaSlice(mockT, [2]int{1, 2}, []float32{1.5, 2.5}, 0.5), "Expected arrays and slices of any numbers to be compared")
+	False(t, InDeltaSlice(mockT, []float64{1, 2}, []float64{1}, 1), "Expected lists of different lengths to fail")
+	False(t, InDeltaSlice(mockT, 1, []float64{1}, 1), "Expected non lists to fail")
+
+	bufT := new(bufferT)
+	InDeltaSlice(bufT, []float64{1, 2, 3}, []float64{1, 2, 4}, 0.5)
+	Contains(t, bufT.buf.String(), "Element 2: Max difference between 3 and 4 allowed is 0.5, but difference was -1")
+}
+
+func TestInDeltaMapValues(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, InDeltaMapValues(mockT, map[string]float64{"a": 1, "b": 2}, map[string]int{"a": 1, "b": 2}, 0.01), "Expected maps with close values to pass")
+	False(t, InDeltaMapValues(mockT, map[string]float64{"a": 1}, map[string]float64{"a": 1, "b": 2}, 0.01), "Expected an extra key to fail")
+	False(t, InDeltaMapValues(mockT, map[string]float64{"a": 1}, map[int]float64{1: 1}, 0.01), "Expected keys of different types to fail")
+	False(t, InDeltaMapValues(mockT, []float64{1}, map[string]float64{"a": 1}, 0.01), "Expected non maps to fail")
+
+	bufT := new(bufferT)
+	InDeltaMapValues(bufT, map[string]float64{"a": 1, "b": 2}, map[string]float64{"a": 1}, 0.01)
+	Contains(t, bufT.buf.String(), `Key "b": missing from actual`)
+
+	bufT = new(bufferT)
+	InDeltaMapValues(bufT, map[string]float64{"a": 1}, map[string]float64{"a": math.NaN()}, 0.01)
+	Contains(t, bufT.buf.String(), `Key "a": Expected 1 and NaN to be within 0.01 of each other, but NaN is never close to anything`)
+}
+
+func TestInEpsilonZeroNaNAndInf(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, InEpsilon(mockT, 0, 0, 0.01), "Expected 0 and 0 to have a relative error of 0")
+	True(t, InEpsilon(mockT, 0.0, -0.0, 0), "Expected 0 and -0 to have a relative error of 0")
+	False(t, InEpsilon(mockT, 0, 0.001, 0.01), "Expected 0 and 0.001 to have an infinite relative error")
+	True(t, InEpsilon(mockT, 0.001, 0, 1), "Expected 0.001 and 0 to have a relative error of 1")
+	False(t, InEpsilon(mockT, math.NaN(), math.NaN(), 1), "Expected NaN to fail")
+	False(t, InEpsilon(mockT, 1, 1, -1), "Expected a negative epsilon to fail")
+	True(t, InEpsilon(mockT, math.Inf(-1), math.Inf(-1), 0), "Expected -Inf to be close to -Inf")
+	False(t, InEpsilon(mockT, math.Inf(-1), 1, math.Inf(1)), "Expected -Inf not to be close to a number")
+}
+
+func TestRelativeError(t *testing.T) {
+	Equal(t, 0.0, relativeError(0, 0))
+	Equal(t, math.Inf(1), relativeError(0, 1))
+	Equal(t, 0.5, relativeError(-2, -1))
+	Equal(t, 2.0, relativeError(1, -1))
+}
+
+func TestInEpsilonSlice(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, InEpsilonSlice(mockT, []float64{100, 200}, []float64{101, 198}, 0.01), "Expected relative errors of at most 0.01")
+	False(t, InEpsilonSlice(mockT, []float64{100, 200}, []float64{101, 197}, 0.01), "Expected a relative error of 0.015 to fail")
+	False(t, InEpsilonSlice(mockT, []float64{100}, nil, 0.01), "Expected lists of different lengths to fail")
+
+	bufT := new(bufferT)
+	InEpsilonSlice(bufT, []float64{0}, []float64{1}, 0.01)
+	Contains(t, bufT.buf.String(), "Element 0: Max relative error between 0 (expected) and 1 (actual) allowed is 0.01, but relative error was +Inf")
+}
+
+func TestIsNaN(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, IsNaN(mockT, math.NaN()), "Expected NaN to be NaN")
+	True(t, IsNaN(mockT, float32(math.NaN())), "Expected float32 NaN to be NaN")
+	False(t, IsNaN(mockT, 1.0), "Expected 1 not to be NaN")
+	False(t, IsNaN(mockT, math.Inf(1)), "Expected +Inf not to be NaN")
+	False(t, IsNaN(mockT, "NaN"), "Expected non numerals to fail")
+}
+
+func TestNotNaN(t *testing.T) {
+	mockT := new(testing.T)
+
+	True(t, NotNaN(mockT, 1.0), "Expected 1 not to be NaN")
+	True(t, NotNaN(mockT, 1), "Expected integers not to be NaN")
+	False(t, NotNaN(mockT, math.NaN()), "Expected NaN to fail")
+	False(t, NotNaN(mockT, nil), "Expected non numerals to fail")
+}
+
 func TestRegexp(t *testing.T) {
 	mockT := new(testing.T)
 
@@ -735,4 +1190,62 @@ func TestRegexp(t *testing.T) {
 		True(t, NotRegexp(mockT, tc.rx, tc.str))
 		True(t, NotRegexp(mockT, regexp.MustCompile(tc.rx), tc.str))
 	}
+
+	False(t, Regexp(mockT, "[", "a"), "Expected an invalid regexp to fail")
+	False(t, NotRegexp(mockT, "[", "a"), "Expected an invalid regexp to fail")
+
+	bufT := new(bufferT)
+	Regexp(bufT, "^start", "not the start", "message %d", 1)
+	Contains(t, bufT.buf.String(), `Expect "not the start" to match "^start"`)
+	Contains(t, bufT.buf.String(), "message 1")
+
+	bufT = new(bufferT)
+	NotRegexp(bufT, regexp.MustCompile("start"), "the start")
+	Contains(t, bufT.buf.String(), `Expect "the start" to NOT match "start"`)
+}
+
+func TestRegexpCaptures(t *testing.T) {
+	mockT := new(testing.T)
+
+	captures, ok := RegexpCaptures(mockT, `(\w+)@(\w+)\.com`, "mail bob@example.com")
+	True(t, ok)
+	Equal(t, []string{"bob", "example"}, captures)
+
+	captures, ok = RegexpCaptures(mockT, regexp.MustCompile(`^\d+$`), "123")
+	True(t, ok)
+	Equal(t, []string{}, captures)
+
+	captures, ok = RegexpCaptures(mockT, `(\w+)@(\w+)\.com`, "no mail")
+	False(t, ok)
+	Nil(t, captures)
+
+	bufT := new(bufferT)
+	_, ok = RegexpCaptures(bufT, "(", "a")
+	False(t, ok)
+	Contains(t, bufT.buf.String(), `Invalid regexp "(": error parsing regexp`)
+}
+
+func TestCompileRegexp(t *testing.T) {
+	r1, err := compileRegexp("^cached$")
+	NoError(t, err)
+	r2, err := compileRegexp("^cached$")
+	NoError(t, err)
+	Same(t, r1, r2, "Expected the compiled pattern to be cached")
+
+	for i := 0; i < 2*regexpCacheSize; i++ {
+		_, err := compileRegexp(fmt.Sprintf("^pattern %d$", i))
+		NoError(t, err)
+	}
+	regexpCacheMutex.Lock()
+	size := len(regexpCache)
+	regexpCacheMutex.Unlock()
+	True(t, size <= regexpCacheSize, "Expected the cache to hold at most %d patterns, not %d", regexpCacheSize, size)
+
+	r := regexp.MustCompile("^given$")
+	r3, err := compileRegexp(r)
+	NoError(t, err)
+	Same(t, r, r3, "Expected a *regexp.Regexp to be used as is")
+
+	_, err = compileRegexp("[")
+	Error(t, err)
 }
@@ -0,0 +1,132 @@
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxDiffLines bounds the number of differing lines diff compares line
+// by line; beyond it, the differing lines are listed as a whole.
+const maxDiffLines = 1000
+
+// diff returns a line by line diff of expected and actual, with lines
+// only in expected prefixed by "-" and lines only in actual prefixed
+// by "+".  Unchanged lines around the differences are prefixed by " ".
+func diff(expected, actual string) string {
+	e := strings.SplitAfter(expected, "\n")
+	a := strings.SplitAfter(actual, "\n")
+
+	// Skip the common start and end, keeping a line of context.
+	start := 0
+	for start < len(e) && start < len(a) && e[start] == a[start] {
+		start++
+	}
+	end := 0
+	for end < len(e)-start && end < len(a)-start && e[len(e)-1-end] == a[len(a)-1-end] {
+		end++
+	}
+
+	buf := new(bytes.Buffer)
+	if start > 0 {
+		writeDiffLine(buf, " ", e[start-1])
+	}
+	var after string
+	if end > 0 {
+		after = e[len(e)-end]
+	}
+	e, a = e[start:len(e)-end], a[start:len(a)-end]
+
+	if len(e) > maxDiffLines || len(a) > maxDiffLines {
+		for _, line := range e {
+			writeDiffLine(buf, "-", line)
+		}
+		for _, line := range a {
+			writeDiffLine(buf, "+", line)
+		}
+	} else {
+		// lcs[i][j] is the length of the longest common subsequence
+		// of e[i:] and a[j:].
+		lcs := make([][]int, len(e)+1)
+		for i := range lcs {
+			lcs[i] = make([]int, len(a)+1)
+		}
+		for i := len(e) - 1; i >= 0; i-- {
+			for j := len(a) - 1; j >= 0; j-- {
+				if e[i] == a[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else if lcs[i+1][j] >= lcs[i][j+1] {
+					lcs[i][j] = lcs[i+1][j]
+				} else {
+					lcs[i][j] = lcs[i][j+1]
+				}
+			}
+		}
+		i, j := 0, 0
+		for i < len(e) || j < len(a) {
+			switch {
+			case i < len(e) && j < len(a) && e[i] == a[j]:
+				writeDiffLine(buf, " ", e[i])
+				i++
+				j++
+			case j == len(a) || (i < len(e) && lcs[i+1][j] >= lcs[i][j+1]):
+				writeDiffLine(buf, "-", e[i])
+				i++
+			default:
+				writeDiffLine(buf, "+", a[j])
+				j++
+			}
+		}
+	}
+
+	if end > 0 {
+		writeDiffLine(buf, " ", after)
+	}
+	return buf.String()
+}
+
+func writeDiffLine(buf *bytes.Buffer, prefix, line string) {
+	buf.WriteString(prefix)
+	buf.WriteString(strings.TrimSuffix(line, "\n"))
+	buf.WriteString("\n")
+}
+
+// diffContent returns a diff of expected and actual, line by line if
+// both are text, or from their first differing byte otherwise.
+func diffContent(expected, actual []byte) string {
+	if isBinary(expected) || isBinary(actual) {
+		return binaryDiff(expected, actual)
+	}
+	return diff(string(expected), string(actual))
+}
+
+// isBinary reports whether b should be shown as bytes rather than as
+// text.
+func isBinary(b []byte) bool {
+	return !utf8.Valid(b) || bytes.IndexByte(b, 0) >= 0
+}
+
+// binaryDiff describes where expected and actual, which are binary,
+// first differ.
+func binaryDiff(expected, actual []byte) string {
+	i := 0
+	for i < len(expected) && i < len(actual) && expected[i] == actual[i] {
+		i++
+	}
+	return fmt.Sprintf("binary content differs from byte %d (expected %d bytes, actual %d bytes)\n"+
+		"expected: % x\n"+
+		"actual:   % x", i, len(expected), len(actual), window(expected, i), window(actual, i))
+}
+
+// window returns at most 16 bytes of b, starting at i.
+func window(b []byte, i int) []byte {
+	if i > len(b) {
+		i = len(b)
+	}
+	end := i + 16
+	if end > len(b) {
+		end = len(b)
+	}
+	return b[i:end]
+}
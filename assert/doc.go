@@ -102,6 +102,10 @@
 //
 //    assert.NotEqual(notExpected, actual [, message [, format-args]])
 //
+//    assert.Same(expectedPointer, actualPointer [, message [, format-args]])
+//
+//    assert.NotSame(expectedPointer, actualPointer [, message [, format-args]])
+//
 //    assert.True(actualBool [, message [, format-args]])
 //
 //    assert.False(actualBool [, message [, format-args]])
@@ -114,6 +118,10 @@
 //
 //    assert.NotEmpty(actualObject [, message [, format-args]])
 //
+//    assert.Zero(actualObject [, message [, format-args]])
+//
+//    assert.NotZero(actualObject [, message [, format-args]])
+//
 //    assert.Len(actualObject, expectedLength, [, message [, format-args]])
 //
 //    assert.Error(errorObject [, message [, format-args]])
@@ -126,9 +134,25 @@
 //
 //    assert.IsType(expectedObject, actualObject [, message [, format-args]])
 //
-//    assert.Contains(stringOrSlice, substringOrElement [, message [, format-args]])
+//    assert.Contains(stringSliceOrMap, substringElementOrKey [, message [, format-args]])
+//
+//    assert.NotContains(stringSliceOrMap, substringElementOrKey [, message [, format-args]])
+//
+//    assert.ContainsKey(map, key [, message [, format-args]])
+//
+//    assert.ContainsValue(map, value [, message [, format-args]])
+//
+//    assert.Regexp(regexpOrPattern, str [, message [, format-args]])
+//
+//    assert.NotRegexp(regexpOrPattern, str [, message [, format-args]])
+//
+//    captures, ok := assert.RegexpCaptures(regexpOrPattern, str [, message [, format-args]])
+//
+//    assert.ElementsMatch(expectedList, actualList [, message [, format-args]])
+//
+//    assert.Subset(listOrMap, subsetListOrMap [, message [, format-args]])
 //
-//    assert.NotContains(stringOrSlice, substringOrElement [, message [, format-args]])
+//    assert.NotSubset(listOrMap, subsetListOrMap [, message [, format-args]])
 //
 //    assert.Panics(func(){
 //
@@ -142,9 +166,77 @@
 //
 //    } [, message [, format-args]])
 //
+//    assert.PanicsWithValue(expectedValue, func(){
+//
+//	    // call code that should panic with expectedValue
+//
+//    } [, message [, format-args]])
+//
+//    assert.PanicsWithError(errStringOrError, func(){
+//
+//	    // call code that should panic with an error
+//
+//    } [, message [, format-args]])
+//
 //    assert.WithinDuration(timeA, timeB, deltaTime, [, message [, format-args]])
 //
+//    assert.Greater(numberA, numberB [, message [, format-args]])
+//
+//    assert.GreaterOrEqual(numberA, numberB [, message [, format-args]])
+//
+//    assert.Less(numberA, numberB [, message [, format-args]])
+//
+//    assert.LessOrEqual(numberA, numberB [, message [, format-args]])
+//
+//    assert.Positive(number [, message [, format-args]])
+//
+//    assert.Negative(number [, message [, format-args]])
+//
+// Greater, GreaterOrEqual, Less and LessOrEqual also compare strings, times
+// and durations.
+//
+//    assert.IsIncreasing(list [, message [, format-args]])
+//
+//    assert.IsNonIncreasing(list [, message [, format-args]])
+//
+//    assert.IsDecreasing(list [, message [, format-args]])
+//
+//    assert.IsNonDecreasing(list [, message [, format-args]])
+//
+//    assert.Sorted(list, func(i, j int) bool { ... } [, message [, format-args]])
+//
 //    assert.InDelta(numA, numB, delta, [, message [, format-args]])
 //
 //    assert.InEpsilon(numA, numB, epsilon, [, message [, format-args]])
+//
+//    assert.InDeltaSlice(listA, listB, delta, [, message [, format-args]])
+//
+//    assert.InDeltaMapValues(mapA, mapB, delta, [, message [, format-args]])
+//
+//    assert.InEpsilonSlice(listA, listB, epsilon, [, message [, format-args]])
+//
+//    assert.IsNaN(number [, message [, format-args]])
+//
+//    assert.NotNaN(number [, message [, format-args]])
+//
+//    assert.FileExists(path [, message [, format-args]])
+//
+//    assert.NoFileExists(path [, message [, format-args]])
+//
+//    assert.DirExists(path [, message [, format-args]])
+//
+//    assert.NoDirExists(path [, message [, format-args]])
+//
+//    assert.FileContains(path, substring [, message [, format-args]])
+//
+//    assert.FileEquals(path, expectedContent [, message [, format-args]])
+//
+//    assert.FileMode(path, mode [, message [, format-args]])
+//
+//    assert.Golden(name, actual [, message [, format-args]])
+//
+//    assert.GoldenText(name, actual [, message [, format-args]])
+//
+// Golden and GoldenText compare actual with the file testdata/<name>.golden,
+// and rewrite that file instead when the tests are run with -testify.update.
 package assert
@@ -0,0 +1,150 @@
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// FileExists asserts that the specified path exists and is not a directory.
+// Symbolic links are followed.
+//
+//	assert.FileExists(t, "testdata/config.json")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func FileExists(t TestingT, path string, msgAndArgs ...interface{}) bool {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return Fail(t, fmt.Sprintf("File %s does not exist", path), msgAndArgs...)
+	}
+	if err != nil {
+		return Fail(t, fmt.Sprintf("Could not check file %s: %s", path, err), msgAndArgs...)
+	}
+	if info.IsDir() {
+		return Fail(t, fmt.Sprintf("%s is a directory, not a file", path), msgAndArgs...)
+	}
+	return true
+}
+
+// NoFileExists asserts that the specified path does not exist, or is a
+// directory.  Symbolic links are followed.
+//
+//	assert.NoFileExists(t, "testdata/output.json")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NoFileExists(t TestingT, path string, msgAndArgs ...interface{}) bool {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return true
+	}
+	if err != nil {
+		return Fail(t, fmt.Sprintf("Could not check file %s: %s", path, err), msgAndArgs...)
+	}
+	if !info.IsDir() {
+		return Fail(t, fmt.Sprintf("File %s exists", path), msgAndArgs...)
+	}
+	return true
+}
+
+// DirExists asserts that the specified path exists and is a directory.
+// Symbolic links are followed.
+//
+//	assert.DirExists(t, "testdata")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func DirExists(t TestingT, path string, msgAndArgs ...interface{}) bool {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return Fail(t, fmt.Sprintf("Directory %s does not exist", path), msgAndArgs...)
+	}
+	if err != nil {
+		return Fail(t, fmt.Sprintf("Could not check directory %s: %s", path, err), msgAndArgs...)
+	}
+	if !info.IsDir() {
+		return Fail(t, fmt.Sprintf("%s is a file, not a directory", path), msgAndArgs...)
+	}
+	return true
+}
+
+// NoDirExists asserts that the specified path does not exist, or is not a
+// directory.  Symbolic links are followed.
+//
+//	assert.NoDirExists(t, "testdata/tmp")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NoDirExists(t TestingT, path string, msgAndArgs ...interface{}) bool {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return true
+	}
+	if err != nil {
+		return Fail(t, fmt.Sprintf("Could not check directory %s: %s", path, err), msgAndArgs...)
+	}
+	if info.IsDir() {
+		return Fail(t, fmt.Sprintf("Directory %s exists", path), msgAndArgs...)
+	}
+	return true
+}
+
+// FileContains asserts that the content of the file at the specified path
+// contains the specified substring.
+//
+//	assert.FileContains(t, "testdata/config.json", `"debug": true`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func FileContains(t TestingT, path string, contains string, msgAndArgs ...interface{}) bool {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Fail(t, fmt.Sprintf("Could not read file: %s", err), msgAndArgs...)
+	}
+	if !strings.Contains(string(content), contains) {
+		return Fail(t, fmt.Sprintf("File %s does not contain %q", path, contains), msgAndArgs...)
+	}
+	return true
+}
+
+// FileEquals asserts that the content of the file at the specified path is
+// equal to expected, a string or a []byte, and shows how they differ when
+// it is not.
+//
+//	assert.FileEquals(t, "out/report.txt", "total: 3\n")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func FileEquals(t TestingT, path string, expected interface{}, msgAndArgs ...interface{}) bool {
+	expectedContent, ok := contentBytes(expected)
+	if !ok {
+		return Fail(t, unsupportedType("FileEquals", expected, "a string or []byte"), msgAndArgs...)
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Fail(t, fmt.Sprintf("Could not read file: %s", err), msgAndArgs...)
+	}
+	if !bytes.Equal(expectedContent, content) {
+		return Fail(t, fmt.Sprintf("Not equal to the content of file %s:\n%s", path, diffContent(expectedContent, content)), msgAndArgs...)
+	}
+	return true
+}
+
+// FileMode asserts that the file or directory at the specified path has the
+// specified permission bits.  The type bits of the file, such as os.ModeDir,
+// are only compared when mode has some.  Symbolic links are followed.
+//
+//	assert.FileMode(t, "bin/run.sh", 0755)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func FileMode(t TestingT, path string, mode os.FileMode, msgAndArgs ...interface{}) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return Fail(t, fmt.Sprintf("Could not check file mode: %s", err), msgAndArgs...)
+	}
+	actual := info.Mode()
+	if mode&os.ModeType == 0 {
+		actual &^= os.ModeType
+	}
+	if actual != mode {
+		return Fail(t, fmt.Sprintf("File %s has mode %v, expected %v", path, actual, mode), msgAndArgs...)
+	}
+	return true
+}
@@ -0,0 +1,161 @@
+package assert
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// tempFiles creates a temporary directory holding a file "file.txt" with
+// the specified content and a directory "dir", and returns its path.
+func tempFiles(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "testify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "file.txt"), []byte(content), 0640); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "dir"), 0750); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestFileExists(t *testing.T) {
+
+	mockT := new(testing.T)
+	dir := tempFiles(t, "")
+	defer os.RemoveAll(dir)
+
+	if !FileExists(mockT, filepath.Join(dir, "file.txt")) {
+		t.Error("FileExists should return true: file.txt exists")
+	}
+	if FileExists(mockT, filepath.Join(dir, "dir")) {
+		t.Error("FileExists should return false: dir is a directory")
+	}
+	if FileExists(mockT, filepath.Join(dir, "missing")) {
+		t.Error("FileExists should return false: missing does not exist")
+	}
+
+	if !NoFileExists(mockT, filepath.Join(dir, "missing")) {
+		t.Error("NoFileExists should return true: missing does not exist")
+	}
+	if !NoFileExists(mockT, filepath.Join(dir, "dir")) {
+		t.Error("NoFileExists should return true: dir is a directory")
+	}
+	if NoFileExists(mockT, filepath.Join(dir, "file.txt")) {
+		t.Error("NoFileExists should return false: file.txt exists")
+	}
+
+}
+
+func TestDirExists(t *testing.T) {
+
+	mockT := new(testing.T)
+	dir := tempFiles(t, "")
+	defer os.RemoveAll(dir)
+
+	if !DirExists(mockT, filepath.Join(dir, "dir")) {
+		t.Error("DirExists should return true: dir exists")
+	}
+	if DirExists(mockT, filepath.Join(dir, "file.txt")) {
+		t.Error("DirExists should return false: file.txt is a file")
+	}
+	if DirExists(mockT, filepath.Join(dir, "missing")) {
+		t.Error("DirExists should return false: missing does not exist")
+	}
+
+	if !NoDirExists(mockT, filepath.Join(dir, "missing")) {
+		t.Error("NoDirExists should return true: missing does not exist")
+	}
+	if !NoDirExists(mockT, filepath.Join(dir, "file.txt")) {
+		t.Error("NoDirExists should return true: file.txt is a file")
+	}
+	if NoDirExists(mockT, filepath.Join(dir, "dir")) {
+		t.Error("NoDirExists should return false: dir exists")
+	}
+
+}
+
+func TestFileContains(t *testing.T) {
+
+	mockT := new(testing.T)
+	dir := tempFiles(t, "Hello World")
+	defer os.RemoveAll(dir)
+
+	if !FileContains(mockT, filepath.Join(dir, "file.txt"), "World") {
+		t.Error("FileContains should return true: file.txt contains \"World\"")
+	}
+	if FileContains(mockT, filepath.Join(dir, "file.txt"), "Earth") {
+		t.Error("FileContains should return false: file.txt does not contain \"Earth\"")
+	}
+	if FileContains(mockT, filepath.Join(dir, "missing"), "") {
+		t.Error("FileContains should return false: missing does not exist")
+	}
+
+}
+
+func TestFileEquals(t *testing.T) {
+
+	mockT := new(testing.T)
+	dir := tempFiles(t, "one\ntwo\n")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "file.txt")
+
+	if !FileEquals(mockT, path, "one\ntwo\n") {
+		t.Error("FileEquals should return true: the content is equal")
+	}
+	if !FileEquals(mockT, path, []byte("one\ntwo\n")) {
+		t.Error("FileEquals should return true: the content is equal")
+	}
+	if FileEquals(mockT, path, 1) {
+		t.Error("FileEquals should return false: 1 is not a string or []byte")
+	}
+
+	bufT := new(bufferT)
+	if FileEquals(bufT, path, "one\n2\n") {
+		t.Error("FileEquals should return false: the second line differs")
+	}
+	Contains(t, bufT.buf.String(), "Not equal to the content of file "+path)
+	Contains(t, bufT.buf.String(), "-2\n")
+	Contains(t, bufT.buf.String(), "+two\n")
+
+}
+
+func TestFileMode(t *testing.T) {
+
+	mockT := new(testing.T)
+	dir := tempFiles(t, "")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "file.txt")
+
+	// The umask may have cleared some bits when the file was created.
+	if err := os.Chmod(path, 0640); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(filepath.Join(dir, "dir"), 0750); err != nil {
+		t.Fatal(err)
+	}
+
+	if !FileMode(mockT, path, 0640) {
+		t.Error("FileMode should return true: file.txt has mode 0640")
+	}
+	if FileMode(mockT, path, 0644) {
+		t.Error("FileMode should return false: file.txt does not have mode 0644")
+	}
+	if !FileMode(mockT, filepath.Join(dir, "dir"), 0750) {
+		t.Error("FileMode should return true: dir has permissions 0750")
+	}
+	if !FileMode(mockT, filepath.Join(dir, "dir"), os.ModeDir|0750) {
+		t.Error("FileMode should return true: dir is a directory with permissions 0750")
+	}
+	if FileMode(mockT, path, os.ModeDir|0640) {
+		t.Error("FileMode should return false: file.txt is not a directory")
+	}
+	if FileMode(mockT, filepath.Join(dir, "missing"), 0640) {
+		t.Error("FileMode should return false: missing does not exist")
+	}
+
+}
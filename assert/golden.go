@@ -0,0 +1,97 @@
+package assert
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+var updateGolden = flag.Bool("testify.update", false, "update the golden files under testdata instead of comparing with them")
+
+// Golden asserts that actual, a string or a []byte, is equal to the
+// content of the golden file testdata/<name>.golden.  When the tests
+// are run with the -testify.update flag, the golden file is written
+// with actual instead.
+//
+//	assert.Golden(t, "render/index.html", renderIndex())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Golden(t TestingT, name string, actual interface{}, msgAndArgs ...interface{}) bool {
+	return golden(t, name, actual, nil, msgAndArgs...)
+}
+
+// GoldenText asserts like Golden that actual is equal to the content
+// of the golden file testdata/<name>.golden, but ignores differences
+// between "\r\n", "\r" and "\n" line endings.
+//
+//	assert.GoldenText(t, "report.txt", report.String())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func GoldenText(t TestingT, name string, actual interface{}, msgAndArgs ...interface{}) bool {
+	return golden(t, name, actual, normalizeLineEndings, msgAndArgs...)
+}
+
+// goldenPath returns the path of the golden file called name.
+func goldenPath(name string) string {
+	return filepath.Join("testdata", filepath.FromSlash(name)+".golden")
+}
+
+// contentBytes returns the bytes of content, which must be a string or
+// a []byte.
+func contentBytes(content interface{}) ([]byte, bool) {
+	switch a := content.(type) {
+	case []byte:
+		return a, true
+	case string:
+		return []byte(a), true
+	}
+	return nil, false
+}
+
+// golden compares actual with the golden file called name, after
+// passing both through normalize, or updates the file if the
+// -testify.update flag is set.
+func golden(t TestingT, name string, actual interface{}, normalize func([]byte) []byte, msgAndArgs ...interface{}) bool {
+	content, ok := contentBytes(actual)
+	if !ok {
+		return Fail(t, fmt.Sprintf("Golden content must be a string or []byte, but was %T", actual), msgAndArgs...)
+	}
+	path := goldenPath(name)
+
+	if *updateGolden {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return Fail(t, fmt.Sprintf("Could not update golden file: %s", err), msgAndArgs...)
+		}
+		if err := ioutil.WriteFile(path, content, 0644); err != nil {
+			return Fail(t, fmt.Sprintf("Could not update golden file: %s", err), msgAndArgs...)
+		}
+		return true
+	}
+
+	expected, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Fail(t, fmt.Sprintf("Golden file %s does not exist, run the tests with -testify.update to create it", path), msgAndArgs...)
+	}
+	if err != nil {
+		return Fail(t, fmt.Sprintf("Could not read golden file: %s", err), msgAndArgs...)
+	}
+
+	if normalize != nil {
+		expected, content = normalize(expected), normalize(content)
+	}
+	if bytes.Equal(expected, content) {
+		return true
+	}
+
+	return Fail(t, fmt.Sprintf("Not equal to golden file %s (run the tests with -testify.update to update it):\n%s", path, diffContent(expected, content)), msgAndArgs...)
+}
+
+// normalizeLineEndings turns the Windows and old Mac line endings in b
+// into "\n".
+func normalizeLineEndings(b []byte) []byte {
+	b = bytes.Replace(b, []byte("\r\n"), []byte("\n"), -1)
+	return bytes.Replace(b, []byte("\r"), []byte("\n"), -1)
+}
@@ -0,0 +1,84 @@
+package assert
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestGolden(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !Golden(mockT, "golden/text", "line one\nline two\nline three\n") {
+		t.Error("Golden should return true: the string matches the golden file")
+	}
+	if !Golden(mockT, "golden/text", []byte("line one\nline two\nline three\n")) {
+		t.Error("Golden should return true: the bytes match the golden file")
+	}
+	if Golden(mockT, "golden/text", 123) {
+		t.Error("Golden should return false: only strings and bytes can be compared")
+	}
+	if Golden(mockT, "golden/missing", "") {
+		t.Error("Golden should return false: the golden file does not exist")
+	}
+	if Golden(mockT, "golden/crlf", "line one\nline two\n") {
+		t.Error("Golden should return false: the line endings differ")
+	}
+
+	bufT := new(bufferT)
+	if Golden(bufT, "golden/text", "line one\nline 2\nline three\n") {
+		t.Error("Golden should return false: the second line differs")
+	}
+	Contains(t, bufT.buf.String(), "Not equal to golden file testdata/golden/text.golden")
+	Contains(t, bufT.buf.String(), " line one\n")
+	Contains(t, bufT.buf.String(), "-line two\n")
+	Contains(t, bufT.buf.String(), "+line 2\n")
+	Contains(t, bufT.buf.String(), " line three\n")
+
+	bufT = new(bufferT)
+	if Golden(bufT, "golden/binary", []byte{0, 1, 4, 3}) {
+		t.Error("Golden should return false: the third byte differs")
+	}
+	Contains(t, bufT.buf.String(), "binary content differs from byte 2 (expected 4 bytes, actual 4 bytes)")
+
+}
+
+func TestGoldenText(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !GoldenText(mockT, "golden/crlf", "line one\nline two\n") {
+		t.Error("GoldenText should return true: only the line endings differ")
+	}
+	if GoldenText(mockT, "golden/crlf", "line one\nline 2\n") {
+		t.Error("GoldenText should return false: the second line differs")
+	}
+
+}
+
+func TestGoldenUpdate(t *testing.T) {
+
+	flag.Set("testify.update", "true")
+	defer flag.Set("testify.update", "false")
+	defer os.RemoveAll("testdata/update")
+
+	mockT := new(testing.T)
+
+	if !Golden(mockT, "update/new", "new content") {
+		t.Error("Golden should return true: the golden file is written")
+	}
+	content, err := ioutil.ReadFile("testdata/update/new.golden")
+	NoError(t, err)
+	Equal(t, "new content", string(content))
+
+}
+
+func TestDiff(t *testing.T) {
+
+	Equal(t, "-a\n+b\n", diff("a", "b"))
+	Equal(t, " a\n-b\n+c\n d\n", diff("a\nb\nd\n", "a\nc\nd\n"))
+	Equal(t, " a\n+b\n c\n", diff("a\nc\n", "a\nb\nc\n"))
+
+}
@@ -13,7 +13,10 @@ import (
 const assertionsForward = `// auto genrated file, do not edit
 package assert
 
-import "time"
+import (
+	"os"
+	"time"
+)
 
 type Assertions struct {
 	t TestingT
@@ -33,6 +36,7 @@ const requirements = `// auto generated file, do not edit
 package require
 
 import (
+	"os"
 	"time"
 
 	"github.com/stretchr/testify/assert"
@@ -50,10 +54,18 @@ func FailNow(t TestingT, failureMessage string, msgAndArgs ...interface{}) {
 }
 {{range .}}
 {{.DocRequire}}
-func {{.Name}}(t TestingT, {{.ParamsRequire}}) {
+func {{.Name}}(t TestingT, {{.ParamsRequire}}) {{if .Value}}{{.Value}} {{end}}{
+{{- if .Value}}
+	value, ok := assert.{{.Name}}(t, {{.Values}})
+	if !ok {
+		t.FailNow()
+	}
+	return value
+{{- else}}
 	if !assert.{{.Name}}(t, {{.Values}}) {
 		t.FailNow()
 	}
+{{- end}}
 }
 {{end}}`
 
@@ -61,6 +73,7 @@ const requirementsForward = `// auto generated file, do not edit
 package require
 
 import (
+	"os"
 	"time"
 
 	"github.com/stretchr/testify/assert"
@@ -75,15 +88,26 @@ func New(t TestingT) *Requirements {
 }
 {{range .}}
 {{.DocRequireFd}}
-func (r *Requirements) {{.Name}}({{.ParamsRequire}}) {
-	{{.Name}}(r.t, {{.Values}})
+func (r *Requirements) {{.Name}}({{.ParamsRequire}}) {{if .Value}}{{.Value}} {{end}}{
+	{{if .Value}}return {{end}}{{.Name}}(r.t, {{.Values}})
 }
 {{end}}`
 
+// the files of the assert package to generate forwarded assertions and
+// requirements for
+var assertFiles = []string{
+	"./assert/assertions.go",
+	"./assert/assertion_compare.go",
+	"./assert/assertion_order.go",
+	"./assert/file_assertions.go",
+	"./assert/golden.go",
+}
+
 // used to prefix assert types in the require package
 var assertTypes = []string{
 	"Comparison",
 	"PanicTestFunc",
+	"LessFunc",
 }
 
 type Node struct {
@@ -95,21 +119,30 @@ type Node struct {
 	ParamsRequire string
 	Values        string
 	Results       string
+
+	// the type of the value returned by assertions that return a value
+	// along with their success, which requirements return alone
+	Value string
 }
 
 func main() {
 	log.SetFlags(0)
 	log.SetPrefix("gen: ")
 
+	var decls []ast.Decl
+
 	fset := token.NewFileSet()
-	parser, err := parser.ParseFile(fset, "./assert/assertions.go", nil, parser.ParseComments)
-	if err != nil {
-		log.Fatalf("parsing error: %s", err)
+	for _, filename := range assertFiles {
+		file, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+		if err != nil {
+			log.Fatalf("parsing error: %s", err)
+		}
+		decls = append(decls, file.Decls...)
 	}
 
 	var nodes []Node
 
-	for _, decl := range parser.Decls {
+	for _, decl := range decls {
 
 		fn, ok := decl.(*ast.FuncDecl)
 		if !ok || !ast.IsExported(fn.Name.Name) || fn.Type.Params.NumFields() == 0 {
@@ -164,7 +197,13 @@ func main() {
 
 			params = append(params, strings.Join(names, ", ")+" "+t)
 			paramsRequire = append(paramsRequire, strings.Join(names, ", ")+" "+tr)
-			values = append(values, strings.Join(names, ", "))
+
+			// pass variadic parameters on as such
+			if strings.HasPrefix(t, "...") {
+				values = append(values, strings.Join(names, ", ")+"...")
+			} else {
+				values = append(values, strings.Join(names, ", "))
+			}
 		}
 
 		node.Params = strings.Join(params, ", ")
@@ -175,17 +214,14 @@ func main() {
 		var results []string
 
 		for _, result := range fn.Type.Results.List {
-			t, ok := result.Type.(*ast.Ident)
-			if !ok {
-				continue
-			}
+			t := getType(result.Type)
 
 			names := make([]string, len(result.Names))
 			for i, name := range result.Names {
 				names[i] = name.Name
 			}
 
-			results = append(results, strings.TrimSpace(strings.Join(names, ", ")+" "+t.Name))
+			results = append(results, strings.TrimSpace(strings.Join(names, ", ")+" "+t))
 		}
 
 		switch l := len(results); {
@@ -194,6 +230,7 @@ func main() {
 			node.Results = results[0]
 		default:
 			node.Results = "(" + strings.Join(results, ", ") + ")"
+			node.Value = getType(fn.Type.Results.List[0].Type)
 		}
 
 		nodes = append(nodes, node)
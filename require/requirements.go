@@ -2,6 +2,7 @@
 package require
 
 import (
+	"os"
 	"time"
 
 	"github.com/stretchr/testify/assert"
@@ -20,7 +21,7 @@ func FailNow(t TestingT, failureMessage string, msgAndArgs ...interface{}) {
 
 // Fail reports a failure through
 func Fail(t TestingT, failureMessage string, msgAndArgs ...interface{}) {
-	if !assert.Fail(t, failureMessage, msgAndArgs) {
+	if !assert.Fail(t, failureMessage, msgAndArgs...) {
 		t.FailNow()
 	}
 }
@@ -29,14 +30,14 @@ func Fail(t TestingT, failureMessage string, msgAndArgs ...interface{}) {
 //
 //    require.Implements(t, (*MyInterface)(nil), new(MyObject), "MyObject")
 func Implements(t TestingT, interfaceObject interface{}, object interface{}, msgAndArgs ...interface{}) {
-	if !assert.Implements(t, interfaceObject, object, msgAndArgs) {
+	if !assert.Implements(t, interfaceObject, object, msgAndArgs...) {
 		t.FailNow()
 	}
 }
 
 // IsType asserts that the specified objects are of the same type.
 func IsType(t TestingT, expectedType interface{}, object interface{}, msgAndArgs ...interface{}) {
-	if !assert.IsType(t, expectedType, object, msgAndArgs) {
+	if !assert.IsType(t, expectedType, object, msgAndArgs...) {
 		t.FailNow()
 	}
 }
@@ -47,7 +48,7 @@ func IsType(t TestingT, expectedType interface{}, object interface{}, msgAndArgs
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Equal(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) {
-	if !assert.Equal(t, expected, actual, msgAndArgs) {
+	if !assert.Equal(t, expected, actual, msgAndArgs...) {
 		t.FailNow()
 	}
 }
@@ -58,7 +59,31 @@ func Equal(t TestingT, expected, actual interface{}, msgAndArgs ...interface{})
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Exactly(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) {
-	if !assert.Exactly(t, expected, actual, msgAndArgs) {
+	if !assert.Exactly(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Same asserts that two pointers have the same type and point to the same
+// object (i.e. hold the same address).
+//
+//    require.Same(t, ptr1, ptr2, "ptr1 and ptr2 should point to the same object")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Same(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) {
+	if !assert.Same(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotSame asserts that two pointers do not point to the same object (i.e. do
+// not hold the same address), or have different types.
+//
+//    require.NotSame(t, ptr1, ptr2, "ptr1 and ptr2 should not point to the same object")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotSame(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) {
+	if !assert.NotSame(t, expected, actual, msgAndArgs...) {
 		t.FailNow()
 	}
 }
@@ -69,7 +94,7 @@ func Exactly(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotNil(t TestingT, object interface{}, msgAndArgs ...interface{}) {
-	if !assert.NotNil(t, object, msgAndArgs) {
+	if !assert.NotNil(t, object, msgAndArgs...) {
 		t.FailNow()
 	}
 }
@@ -80,25 +105,27 @@ func NotNil(t TestingT, object interface{}, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Nil(t TestingT, object interface{}, msgAndArgs ...interface{}) {
-	if !assert.Nil(t, object, msgAndArgs) {
+	if !assert.Nil(t, object, msgAndArgs...) {
 		t.FailNow()
 	}
 }
 
-// Empty asserts that the specified object is empty.  I.e. nil, "", false, 0 or either
-// a slice or a channel with len == 0.
+// Empty asserts that the specified object is empty.  I.e. nil, "", false, 0, a
+// slice, a map or a channel with len == 0, an array or a struct whose fields are
+// all zero, or a pointer to an empty object.
 //
 // require.Empty(t, obj)
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Empty(t TestingT, object interface{}, msgAndArgs ...interface{}) {
-	if !assert.Empty(t, object, msgAndArgs) {
+	if !assert.Empty(t, object, msgAndArgs...) {
 		t.FailNow()
 	}
 }
 
-// NotEmpty asserts that the specified object is NOT empty.  I.e. not nil, "", false, 0 or either
-// a slice or a channel with len == 0.
+// NotEmpty asserts that the specified object is NOT empty.  I.e. not nil, "", false,
+// 0, a slice, a map or a channel with len == 0, an array or a struct whose fields are
+// all zero, or a pointer to an empty object.
 //
 // if require.NotEmpty(t, obj) {
 //   require.Equal(t, "two", obj[1])
@@ -106,7 +133,32 @@ func Empty(t TestingT, object interface{}, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotEmpty(t TestingT, object interface{}, msgAndArgs ...interface{}) {
-	if !assert.NotEmpty(t, object, msgAndArgs) {
+	if !assert.NotEmpty(t, object, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Zero asserts that the specified object is nil or the zero value of its type.
+// Unlike with Empty, a non-nil slice, map or channel is not zero, even when it
+// has no elements, and neither is a non-nil pointer.
+//
+//    require.Zero(t, obj)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Zero(t TestingT, object interface{}, msgAndArgs ...interface{}) {
+	if !assert.Zero(t, object, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotZero asserts that the specified object is neither nil nor the zero value
+// of its type.
+//
+//    require.NotZero(t, obj)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotZero(t TestingT, object interface{}, msgAndArgs ...interface{}) {
+	if !assert.NotZero(t, object, msgAndArgs...) {
 		t.FailNow()
 	}
 }
@@ -118,7 +170,7 @@ func NotEmpty(t TestingT, object interface{}, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Len(t TestingT, object interface{}, length int, msgAndArgs ...interface{}) {
-	if !assert.Len(t, object, length, msgAndArgs) {
+	if !assert.Len(t, object, length, msgAndArgs...) {
 		t.FailNow()
 	}
 }
@@ -129,7 +181,7 @@ func Len(t TestingT, object interface{}, length int, msgAndArgs ...interface{})
 //
 // Returns whether the assertion was successful (true) or not (false).
 func True(t TestingT, value bool, msgAndArgs ...interface{}) {
-	if !assert.True(t, value, msgAndArgs) {
+	if !assert.True(t, value, msgAndArgs...) {
 		t.FailNow()
 	}
 }
@@ -140,7 +192,7 @@ func True(t TestingT, value bool, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func False(t TestingT, value bool, msgAndArgs ...interface{}) {
-	if !assert.False(t, value, msgAndArgs) {
+	if !assert.False(t, value, msgAndArgs...) {
 		t.FailNow()
 	}
 }
@@ -151,40 +203,104 @@ func False(t TestingT, value bool, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotEqual(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) {
-	if !assert.NotEqual(t, expected, actual, msgAndArgs) {
+	if !assert.NotEqual(t, expected, actual, msgAndArgs...) {
 		t.FailNow()
 	}
 }
 
-// Contains asserts that the specified string or list(array, slice...) contains the
-// specified substring or element.
+// Contains asserts that the specified string, list(array, slice...) or map contains the
+// specified substring, element or key.
 //
 //    require.Contains(t, "Hello World", "World", "But 'Hello World' does contain 'World'")
 //    require.Contains(t, ["Hello", "World"], "World", "But ["Hello", "World"] does contain 'World'")
+//    require.Contains(t, {"Hello": "World"}, "Hello", "But {'Hello': 'World'} does contain 'Hello'")
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Contains(t TestingT, s, contains interface{}, msgAndArgs ...interface{}) {
-	if !assert.Contains(t, s, contains, msgAndArgs) {
+	if !assert.Contains(t, s, contains, msgAndArgs...) {
 		t.FailNow()
 	}
 }
 
-// NotContains asserts that the specified string or list(array, slice...) does NOT contain the
-// specified substring or element.
+// NotContains asserts that the specified string, list(array, slice...) or map does NOT contain the
+// specified substring, element or key.
 //
 //    require.NotContains(t, "Hello World", "Earth", "But 'Hello World' does NOT contain 'Earth'")
 //    require.NotContains(t, ["Hello", "World"], "Earth", "But ['Hello', 'World'] does NOT contain 'Earth'")
+//    require.NotContains(t, {"Hello": "World"}, "Earth", "But {'Hello': 'World'} does NOT contain 'Earth'")
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotContains(t TestingT, s, contains interface{}, msgAndArgs ...interface{}) {
-	if !assert.NotContains(t, s, contains, msgAndArgs) {
+	if !assert.NotContains(t, s, contains, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// ContainsKey asserts that the specified map has the specified key.
+//
+//    require.ContainsKey(t, {"Hello": "World"}, "Hello", "But {'Hello': 'World'} does have the key 'Hello'")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ContainsKey(t TestingT, m, key interface{}, msgAndArgs ...interface{}) {
+	if !assert.ContainsKey(t, m, key, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// ContainsValue asserts that the specified map has the specified value for some key.
+//
+//    require.ContainsValue(t, {"Hello": "World"}, "World", "But {'Hello': 'World'} does have the value 'World'")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ContainsValue(t TestingT, m, value interface{}, msgAndArgs ...interface{}) {
+	if !assert.ContainsValue(t, m, value, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// ElementsMatch asserts that the specified lists (arrays or slices) contain
+// the same elements, regardless of their order.  Duplicates are counted, so
+// each element must appear the same number of times in both lists.
+//
+//    require.ElementsMatch(t, []int{1, 3, 2, 3}, []int{3, 3, 1, 2}, "The lists should have the same elements")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ElementsMatch(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) {
+	if !assert.ElementsMatch(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Subset asserts that every element of subset is contained in list, when
+// both are lists (arrays or slices), or that every entry of subset is in
+// list with an equal value, when both are maps.  Duplicates are not counted.
+//
+//    require.Subset(t, []int{1, 2, 3}, []int{3, 1}, "1 and 3 should be in the list")
+//    require.Subset(t, map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Subset(t TestingT, list, subset interface{}, msgAndArgs ...interface{}) {
+	if !assert.Subset(t, list, subset, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotSubset asserts that some element of subset is not contained in list,
+// when both are lists (arrays or slices), or that some entry of subset is
+// not in list with an equal value, when both are maps.
+//
+//    require.NotSubset(t, []int{1, 2, 3}, []int{4, 1}, "4 should not be in the list")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotSubset(t TestingT, list, subset interface{}, msgAndArgs ...interface{}) {
+	if !assert.NotSubset(t, list, subset, msgAndArgs...) {
 		t.FailNow()
 	}
 }
 
 // Condition uses a Comparison to assert a complex condition.
 func Condition(t TestingT, comp assert.Comparison, msgAndArgs ...interface{}) {
-	if !assert.Condition(t, comp, msgAndArgs) {
+	if !assert.Condition(t, comp, msgAndArgs...) {
 		t.FailNow()
 	}
 }
@@ -197,7 +313,40 @@ func Condition(t TestingT, comp assert.Comparison, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Panics(t TestingT, f assert.PanicTestFunc, msgAndArgs ...interface{}) {
-	if !assert.Panics(t, f, msgAndArgs) {
+	if !assert.Panics(t, f, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// PanicsWithValue asserts that the code inside the specified PanicTestFunc panics,
+// and that the recovered panic value equals the expected value.
+//
+//   require.PanicsWithValue(t, "crazy error", func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with \"crazy error\"")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func PanicsWithValue(t TestingT, expected interface{}, f assert.PanicTestFunc, msgAndArgs ...interface{}) {
+	if !assert.PanicsWithValue(t, expected, f, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// PanicsWithError asserts that the code inside the specified PanicTestFunc panics
+// with an error.  When expected is a string, the message of the error must equal
+// it; when expected is an error, the error must match it, as errors.Is does
+// through the chain of wrapped errors.
+//
+//   require.PanicsWithError(t, "crazy error", func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with the \"crazy error\" error")
+//   require.PanicsWithError(t, ErrCrazy, func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with ErrCrazy")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func PanicsWithError(t TestingT, expected interface{}, f assert.PanicTestFunc, msgAndArgs ...interface{}) {
+	if !assert.PanicsWithError(t, expected, f, msgAndArgs...) {
 		t.FailNow()
 	}
 }
@@ -210,7 +359,7 @@ func Panics(t TestingT, f assert.PanicTestFunc, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotPanics(t TestingT, f assert.PanicTestFunc, msgAndArgs ...interface{}) {
-	if !assert.NotPanics(t, f, msgAndArgs) {
+	if !assert.NotPanics(t, f, msgAndArgs...) {
 		t.FailNow()
 	}
 }
@@ -221,27 +370,93 @@ func NotPanics(t TestingT, f assert.PanicTestFunc, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func WithinDuration(t TestingT, expected, actual time.Time, delta time.Duration, msgAndArgs ...interface{}) {
-	if !assert.WithinDuration(t, expected, actual, delta, msgAndArgs) {
+	if !assert.WithinDuration(t, expected, actual, delta, msgAndArgs...) {
 		t.FailNow()
 	}
 }
 
 // InDelta asserts that the two numerals are within delta of each other.
+// NaN is not within any delta of anything, and an infinity is only within
+// delta of the same infinity.
 //
 // 	 require.InDelta(t, math.Pi, (22 / 7.0), 0.01)
 //
 // Returns whether the assertion was successful (true) or not (false).
 func InDelta(t TestingT, expected, actual interface{}, delta float64, msgAndArgs ...interface{}) {
-	if !assert.InDelta(t, expected, actual, delta, msgAndArgs) {
+	if !assert.InDelta(t, expected, actual, delta, msgAndArgs...) {
 		t.FailNow()
 	}
 }
 
-// InEpsilon asserts that expected and actual have a relative error less than epsilon
+// InDeltaSlice asserts that the two lists (arrays or slices) have the same
+// length, and that their elements at the same index are within delta of
+// each other.
+//
+// 	 require.InDeltaSlice(t, []float64{math.Pi, math.E}, []float64{3.14, 2.72}, 0.01)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func InDeltaSlice(t TestingT, expected, actual interface{}, delta float64, msgAndArgs ...interface{}) {
+	if !assert.InDeltaSlice(t, expected, actual, delta, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// InDeltaMapValues asserts that the two maps have the same keys, and that
+// their values for the same key are within delta of each other.
+//
+// 	 require.InDeltaMapValues(t, map[string]float64{"pi": math.Pi}, map[string]float64{"pi": 3.14}, 0.01)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func InDeltaMapValues(t TestingT, expected, actual interface{}, delta float64, msgAndArgs ...interface{}) {
+	if !assert.InDeltaMapValues(t, expected, actual, delta, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// InEpsilon asserts that expected and actual have a relative error of at most
+// epsilon, the relative error being |expected - actual| / |expected|.
+// When expected is zero, actual must be zero too.
+//
+// 	 require.InEpsilon(t, 100, 101, 0.01)
 //
 // Returns whether the assertion was successful (true) or not (false).
 func InEpsilon(t TestingT, expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) {
-	if !assert.InEpsilon(t, expected, actual, epsilon, msgAndArgs) {
+	if !assert.InEpsilon(t, expected, actual, epsilon, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// InEpsilonSlice asserts that the two lists (arrays or slices) have the same
+// length, and that their elements at the same index have a relative error of
+// at most epsilon, as defined by InEpsilon.
+//
+// 	 require.InEpsilonSlice(t, []float64{100, 200}, []float64{101, 198}, 0.01)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func InEpsilonSlice(t TestingT, expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) {
+	if !assert.InEpsilonSlice(t, expected, actual, epsilon, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// IsNaN asserts that the specified number is NaN (not a number).
+//
+// 	 require.IsNaN(t, math.Sqrt(-1))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsNaN(t TestingT, f interface{}, msgAndArgs ...interface{}) {
+	if !assert.IsNaN(t, f, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotNaN asserts that the specified number is not NaN (not a number).
+//
+// 	 require.NotNaN(t, math.Sqrt(2))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotNaN(t TestingT, f interface{}, msgAndArgs ...interface{}) {
+	if !assert.NotNaN(t, f, msgAndArgs...) {
 		t.FailNow()
 	}
 }
@@ -255,7 +470,7 @@ func InEpsilon(t TestingT, expected, actual interface{}, epsilon float64, msgAnd
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NoError(t TestingT, err error, msgAndArgs ...interface{}) {
-	if !assert.NoError(t, err, msgAndArgs) {
+	if !assert.NoError(t, err, msgAndArgs...) {
 		t.FailNow()
 	}
 }
@@ -269,7 +484,7 @@ func NoError(t TestingT, err error, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Error(t TestingT, err error, msgAndArgs ...interface{}) {
-	if !assert.Error(t, err, msgAndArgs) {
+	if !assert.Error(t, err, msgAndArgs...) {
 		t.FailNow()
 	}
 }
@@ -284,31 +499,298 @@ func Error(t TestingT, err error, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func EqualError(t TestingT, theError error, errString string, msgAndArgs ...interface{}) {
-	if !assert.EqualError(t, theError, errString, msgAndArgs) {
+	if !assert.EqualError(t, theError, errString, msgAndArgs...) {
 		t.FailNow()
 	}
 }
 
-// Regexp asserts that a specified regexp matches a string.
+// Regexp asserts that a specified regexp matches a string.  The regexp is
+// either a *regexp.Regexp or a pattern, compiled once and cached.
 //
 //  require.Regexp(t, regexp.MustCompile("start"), "it's starting")
 //  require.Regexp(t, "start...$", "it's not starting")
 //
 // Returns whether the assertion was successful (true) or not (false).
-func Regexp(t TestingT, rx interface{}, str interface{}) {
-	if !assert.Regexp(t, rx, str) {
+func Regexp(t TestingT, rx interface{}, str interface{}, msgAndArgs ...interface{}) {
+	if !assert.Regexp(t, rx, str, msgAndArgs...) {
 		t.FailNow()
 	}
 }
 
-// NotRegexp asserts that a specified regexp does not match a string.
+// NotRegexp asserts that a specified regexp does not match a string.  The
+// regexp is either a *regexp.Regexp or a pattern, compiled once and cached.
 //
 //  require.NotRegexp(t, regexp.MustCompile("starts"), "it's starting")
 //  require.NotRegexp(t, "^start", "it's not starting")
 //
 // Returns whether the assertion was successful (true) or not (false).
-func NotRegexp(t TestingT, rx interface{}, str interface{}) {
-	if !assert.NotRegexp(t, rx, str) {
+func NotRegexp(t TestingT, rx interface{}, str interface{}, msgAndArgs ...interface{}) {
+	if !assert.NotRegexp(t, rx, str, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RegexpCaptures asserts that a specified regexp matches a string, and returns
+// the groups captured by its leftmost match.  The regexp is either a
+// *regexp.Regexp or a pattern, compiled once and cached.
+//
+//  require.RegexpCaptures(t, `(\w+)@(\w+)\.com`, "bob@example.com") // []string{"bob", "example"}
+//
+// Returns the captured groups, and whether the assertion was successful (true) or not (false).
+func RegexpCaptures(t TestingT, rx interface{}, str interface{}, msgAndArgs ...interface{}) []string {
+	value, ok := assert.RegexpCaptures(t, rx, str, msgAndArgs...)
+	if !ok {
+		t.FailNow()
+	}
+	return value
+}
+
+// Greater asserts that the first element is greater than the second.
+//
+//	require.Greater(t, 2, 1)
+//	require.Greater(t, float64(2), float32(1))
+//	require.Greater(t, "b", "a")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Greater(t TestingT, e1, e2 interface{}, msgAndArgs ...interface{}) {
+	if !assert.Greater(t, e1, e2, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// GreaterOrEqual asserts that the first element is greater than or equal to the second.
+//
+//	require.GreaterOrEqual(t, 2, 1)
+//	require.GreaterOrEqual(t, 2, 2)
+//	require.GreaterOrEqual(t, "b", "a")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func GreaterOrEqual(t TestingT, e1, e2 interface{}, msgAndArgs ...interface{}) {
+	if !assert.GreaterOrEqual(t, e1, e2, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Less asserts that the first element is less than the second.
+//
+//	require.Less(t, 1, 2)
+//	require.Less(t, float64(1), float32(2))
+//	require.Less(t, "a", "b")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Less(t TestingT, e1, e2 interface{}, msgAndArgs ...interface{}) {
+	if !assert.Less(t, e1, e2, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// LessOrEqual asserts that the first element is less than or equal to the second.
+//
+//	require.LessOrEqual(t, 1, 2)
+//	require.LessOrEqual(t, 2, 2)
+//	require.LessOrEqual(t, "a", "b")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func LessOrEqual(t TestingT, e1, e2 interface{}, msgAndArgs ...interface{}) {
+	if !assert.LessOrEqual(t, e1, e2, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Positive asserts that the specified number is greater than zero.
+//
+//	require.Positive(t, 1)
+//	require.Positive(t, time.Second)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Positive(t TestingT, e interface{}, msgAndArgs ...interface{}) {
+	if !assert.Positive(t, e, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Negative asserts that the specified number is less than zero.
+//
+//	require.Negative(t, -1)
+//	require.Negative(t, -time.Second)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Negative(t TestingT, e interface{}, msgAndArgs ...interface{}) {
+	if !assert.Negative(t, e, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// IsIncreasing asserts that each element of the list is greater than the previous one.
+//
+//	require.IsIncreasing(t, []int{1, 2, 3})
+//	require.IsIncreasing(t, []string{"a", "b"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsIncreasing(t TestingT, list interface{}, msgAndArgs ...interface{}) {
+	if !assert.IsIncreasing(t, list, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// IsNonIncreasing asserts that each element of the list is less than or equal to the previous one.
+//
+//	require.IsNonIncreasing(t, []int{2, 1, 1})
+//	require.IsNonIncreasing(t, []string{"b", "a"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsNonIncreasing(t TestingT, list interface{}, msgAndArgs ...interface{}) {
+	if !assert.IsNonIncreasing(t, list, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// IsDecreasing asserts that each element of the list is less than the previous one.
+//
+//	require.IsDecreasing(t, []int{3, 2, 1})
+//	require.IsDecreasing(t, []string{"b", "a"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsDecreasing(t TestingT, list interface{}, msgAndArgs ...interface{}) {
+	if !assert.IsDecreasing(t, list, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// IsNonDecreasing asserts that each element of the list is greater than or equal to the previous one.
+//
+//	require.IsNonDecreasing(t, []int{1, 1, 2})
+//	require.IsNonDecreasing(t, []string{"a", "b"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsNonDecreasing(t TestingT, list interface{}, msgAndArgs ...interface{}) {
+	if !assert.IsNonDecreasing(t, list, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Sorted asserts that the list (array or slice) is sorted according to less,
+// which reports whether the element at index i must sort before the element
+// at index j, as with sort.Slice.
+//
+//	require.Sorted(t, people, func(i, j int) bool { return people[i].Age < people[j].Age })
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Sorted(t TestingT, list interface{}, less assert.LessFunc, msgAndArgs ...interface{}) {
+	if !assert.Sorted(t, list, less, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// FileExists asserts that the specified path exists and is not a directory.
+// Symbolic links are followed.
+//
+//	require.FileExists(t, "testdata/config.json")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func FileExists(t TestingT, path string, msgAndArgs ...interface{}) {
+	if !assert.FileExists(t, path, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NoFileExists asserts that the specified path does not exist, or is a
+// directory.  Symbolic links are followed.
+//
+//	require.NoFileExists(t, "testdata/output.json")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NoFileExists(t TestingT, path string, msgAndArgs ...interface{}) {
+	if !assert.NoFileExists(t, path, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// DirExists asserts that the specified path exists and is a directory.
+// Symbolic links are followed.
+//
+//	require.DirExists(t, "testdata")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func DirExists(t TestingT, path string, msgAndArgs ...interface{}) {
+	if !assert.DirExists(t, path, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NoDirExists asserts that the specified path does not exist, or is not a
+// directory.  Symbolic links are followed.
+//
+//	require.NoDirExists(t, "testdata/tmp")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NoDirExists(t TestingT, path string, msgAndArgs ...interface{}) {
+	if !assert.NoDirExists(t, path, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// FileContains asserts that the content of the file at the specified path
+// contains the specified substring.
+//
+//	require.FileContains(t, "testdata/config.json", `"debug": true`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func FileContains(t TestingT, path string, contains string, msgAndArgs ...interface{}) {
+	if !assert.FileContains(t, path, contains, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// FileEquals asserts that the content of the file at the specified path is
+// equal to expected, a string or a []byte, and shows how they differ when
+// it is not.
+//
+//	require.FileEquals(t, "out/report.txt", "total: 3\n")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func FileEquals(t TestingT, path string, expected interface{}, msgAndArgs ...interface{}) {
+	if !assert.FileEquals(t, path, expected, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// FileMode asserts that the file or directory at the specified path has the
+// specified permission bits.  The type bits of the file, such as os.ModeDir,
+// are only compared when mode has some.  Symbolic links are followed.
+//
+//	require.FileMode(t, "bin/run.sh", 0755)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func FileMode(t TestingT, path string, mode os.FileMode, msgAndArgs ...interface{}) {
+	if !assert.FileMode(t, path, mode, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Golden asserts that actual, a string or a []byte, is equal to the
+// content of the golden file testdata/<name>.golden.  When the tests
+// are run with the -testify.update flag, the golden file is written
+// with actual instead.
+//
+//	require.Golden(t, "render/index.html", renderIndex())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Golden(t TestingT, name string, actual interface{}, msgAndArgs ...interface{}) {
+	if !assert.Golden(t, name, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// GoldenText asserts like Golden that actual is equal to the content
+// of the golden file testdata/<name>.golden, but ignores differences
+// between "\r\n", "\r" and "\n" line endings.
+//
+//	require.GoldenText(t, "report.txt", report.String())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func GoldenText(t TestingT, name string, actual interface{}, msgAndArgs ...interface{}) {
+	if !assert.GoldenText(t, name, actual, msgAndArgs...) {
 		t.FailNow()
 	}
 }
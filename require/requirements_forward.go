@@ -2,6 +2,7 @@
 package require
 
 import (
+	"os"
 	"time"
 
 	"github.com/stretchr/testify/assert"
@@ -17,19 +18,19 @@ func New(t TestingT) *Requirements {
 
 // Fail reports a failure through
 func (r *Requirements) Fail(failureMessage string, msgAndArgs ...interface{}) {
-	Fail(r.t, failureMessage, msgAndArgs)
+	Fail(r.t, failureMessage, msgAndArgs...)
 }
 
 // Implements asserts that an object is implemented by the specified interface.
 //
 //    require.Implements((*MyInterface)(nil), new(MyObject), "MyObject")
 func (r *Requirements) Implements(interfaceObject interface{}, object interface{}, msgAndArgs ...interface{}) {
-	Implements(r.t, interfaceObject, object, msgAndArgs)
+	Implements(r.t, interfaceObject, object, msgAndArgs...)
 }
 
 // IsType asserts that the specified objects are of the same type.
 func (r *Requirements) IsType(expectedType interface{}, object interface{}, msgAndArgs ...interface{}) {
-	IsType(r.t, expectedType, object, msgAndArgs)
+	IsType(r.t, expectedType, object, msgAndArgs...)
 }
 
 // Equal asserts that two objects are equal.
@@ -38,7 +39,7 @@ func (r *Requirements) IsType(expectedType interface{}, object interface{}, msgA
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) Equal(expected, actual interface{}, msgAndArgs ...interface{}) {
-	Equal(r.t, expected, actual, msgAndArgs)
+	Equal(r.t, expected, actual, msgAndArgs...)
 }
 
 // Exactly asserts that two objects are equal is value and type.
@@ -47,7 +48,27 @@ func (r *Requirements) Equal(expected, actual interface{}, msgAndArgs ...interfa
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) Exactly(expected, actual interface{}, msgAndArgs ...interface{}) {
-	Exactly(r.t, expected, actual, msgAndArgs)
+	Exactly(r.t, expected, actual, msgAndArgs...)
+}
+
+// Same asserts that two pointers have the same type and point to the same
+// object (i.e. hold the same address).
+//
+//    require.Same(ptr1, ptr2, "ptr1 and ptr2 should point to the same object")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) Same(expected, actual interface{}, msgAndArgs ...interface{}) {
+	Same(r.t, expected, actual, msgAndArgs...)
+}
+
+// NotSame asserts that two pointers do not point to the same object (i.e. do
+// not hold the same address), or have different types.
+//
+//    require.NotSame(ptr1, ptr2, "ptr1 and ptr2 should not point to the same object")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) NotSame(expected, actual interface{}, msgAndArgs ...interface{}) {
+	NotSame(r.t, expected, actual, msgAndArgs...)
 }
 
 // NotNil asserts that the specified object is not nil.
@@ -56,7 +77,7 @@ func (r *Requirements) Exactly(expected, actual interface{}, msgAndArgs ...inter
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) NotNil(object interface{}, msgAndArgs ...interface{}) {
-	NotNil(r.t, object, msgAndArgs)
+	NotNil(r.t, object, msgAndArgs...)
 }
 
 // Nil asserts that the specified object is nil.
@@ -65,21 +86,23 @@ func (r *Requirements) NotNil(object interface{}, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) Nil(object interface{}, msgAndArgs ...interface{}) {
-	Nil(r.t, object, msgAndArgs)
+	Nil(r.t, object, msgAndArgs...)
 }
 
-// Empty asserts that the specified object is empty.  I.e. nil, "", false, 0 or either
-// a slice or a channel with len == 0.
+// Empty asserts that the specified object is empty.  I.e. nil, "", false, 0, a
+// slice, a map or a channel with len == 0, an array or a struct whose fields are
+// all zero, or a pointer to an empty object.
 //
 // require.Empty(obj)
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) Empty(object interface{}, msgAndArgs ...interface{}) {
-	Empty(r.t, object, msgAndArgs)
+	Empty(r.t, object, msgAndArgs...)
 }
 
-// NotEmpty asserts that the specified object is NOT empty.  I.e. not nil, "", false, 0 or either
-// a slice or a channel with len == 0.
+// NotEmpty asserts that the specified object is NOT empty.  I.e. not nil, "", false,
+// 0, a slice, a map or a channel with len == 0, an array or a struct whose fields are
+// all zero, or a pointer to an empty object.
 //
 // if require.NotEmpty(obj) {
 //   require.Equal("two", obj[1])
@@ -87,7 +110,28 @@ func (r *Requirements) Empty(object interface{}, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) NotEmpty(object interface{}, msgAndArgs ...interface{}) {
-	NotEmpty(r.t, object, msgAndArgs)
+	NotEmpty(r.t, object, msgAndArgs...)
+}
+
+// Zero asserts that the specified object is nil or the zero value of its type.
+// Unlike with Empty, a non-nil slice, map or channel is not zero, even when it
+// has no elements, and neither is a non-nil pointer.
+//
+//    require.Zero(obj)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) Zero(object interface{}, msgAndArgs ...interface{}) {
+	Zero(r.t, object, msgAndArgs...)
+}
+
+// NotZero asserts that the specified object is neither nil nor the zero value
+// of its type.
+//
+//    require.NotZero(obj)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) NotZero(object interface{}, msgAndArgs ...interface{}) {
+	NotZero(r.t, object, msgAndArgs...)
 }
 
 // Len asserts that the specified object has specific length.
@@ -97,7 +141,7 @@ func (r *Requirements) NotEmpty(object interface{}, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) Len(object interface{}, length int, msgAndArgs ...interface{}) {
-	Len(r.t, object, length, msgAndArgs)
+	Len(r.t, object, length, msgAndArgs...)
 }
 
 // True asserts that the specified value is true.
@@ -106,7 +150,7 @@ func (r *Requirements) Len(object interface{}, length int, msgAndArgs ...interfa
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) True(value bool, msgAndArgs ...interface{}) {
-	True(r.t, value, msgAndArgs)
+	True(r.t, value, msgAndArgs...)
 }
 
 // False asserts that the specified value is true.
@@ -115,7 +159,7 @@ func (r *Requirements) True(value bool, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) False(value bool, msgAndArgs ...interface{}) {
-	False(r.t, value, msgAndArgs)
+	False(r.t, value, msgAndArgs...)
 }
 
 // NotEqual asserts that the specified values are NOT equal.
@@ -124,34 +168,88 @@ func (r *Requirements) False(value bool, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) NotEqual(expected, actual interface{}, msgAndArgs ...interface{}) {
-	NotEqual(r.t, expected, actual, msgAndArgs)
+	NotEqual(r.t, expected, actual, msgAndArgs...)
 }
 
-// Contains asserts that the specified string or list(array, slice...) contains the
-// specified substring or element.
+// Contains asserts that the specified string, list(array, slice...) or map contains the
+// specified substring, element or key.
 //
 //    require.Contains("Hello World", "World", "But 'Hello World' does contain 'World'")
 //    require.Contains(["Hello", "World"], "World", "But ["Hello", "World"] does contain 'World'")
+//    require.Contains({"Hello": "World"}, "Hello", "But {'Hello': 'World'} does contain 'Hello'")
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) Contains(s, contains interface{}, msgAndArgs ...interface{}) {
-	Contains(r.t, s, contains, msgAndArgs)
+	Contains(r.t, s, contains, msgAndArgs...)
 }
 
-// NotContains asserts that the specified string or list(array, slice...) does NOT contain the
-// specified substring or element.
+// NotContains asserts that the specified string, list(array, slice...) or map does NOT contain the
+// specified substring, element or key.
 //
 //    require.NotContains("Hello World", "Earth", "But 'Hello World' does NOT contain 'Earth'")
 //    require.NotContains(["Hello", "World"], "Earth", "But ['Hello', 'World'] does NOT contain 'Earth'")
+//    require.NotContains({"Hello": "World"}, "Earth", "But {'Hello': 'World'} does NOT contain 'Earth'")
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) NotContains(s, contains interface{}, msgAndArgs ...interface{}) {
-	NotContains(r.t, s, contains, msgAndArgs)
+	NotContains(r.t, s, contains, msgAndArgs...)
+}
+
+// ContainsKey asserts that the specified map has the specified key.
+//
+//    require.ContainsKey({"Hello": "World"}, "Hello", "But {'Hello': 'World'} does have the key 'Hello'")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) ContainsKey(m, key interface{}, msgAndArgs ...interface{}) {
+	ContainsKey(r.t, m, key, msgAndArgs...)
+}
+
+// ContainsValue asserts that the specified map has the specified value for some key.
+//
+//    require.ContainsValue({"Hello": "World"}, "World", "But {'Hello': 'World'} does have the value 'World'")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) ContainsValue(m, value interface{}, msgAndArgs ...interface{}) {
+	ContainsValue(r.t, m, value, msgAndArgs...)
+}
+
+// ElementsMatch asserts that the specified lists (arrays or slices) contain
+// the same elements, regardless of their order.  Duplicates are counted, so
+// each element must appear the same number of times in both lists.
+//
+//    require.ElementsMatch([]int{1, 3, 2, 3}, []int{3, 3, 1, 2}, "The lists should have the same elements")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) ElementsMatch(expected, actual interface{}, msgAndArgs ...interface{}) {
+	ElementsMatch(r.t, expected, actual, msgAndArgs...)
+}
+
+// Subset asserts that every element of subset is contained in list, when
+// both are lists (arrays or slices), or that every entry of subset is in
+// list with an equal value, when both are maps.  Duplicates are not counted.
+//
+//    require.Subset([]int{1, 2, 3}, []int{3, 1}, "1 and 3 should be in the list")
+//    require.Subset(map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) Subset(list, subset interface{}, msgAndArgs ...interface{}) {
+	Subset(r.t, list, subset, msgAndArgs...)
+}
+
+// NotSubset asserts that some element of subset is not contained in list,
+// when both are lists (arrays or slices), or that some entry of subset is
+// not in list with an equal value, when both are maps.
+//
+//    require.NotSubset([]int{1, 2, 3}, []int{4, 1}, "4 should not be in the list")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) NotSubset(list, subset interface{}, msgAndArgs ...interface{}) {
+	NotSubset(r.t, list, subset, msgAndArgs...)
 }
 
 // Condition uses a Comparison to assert a complex condition.
 func (r *Requirements) Condition(comp assert.Comparison, msgAndArgs ...interface{}) {
-	Condition(r.t, comp, msgAndArgs)
+	Condition(r.t, comp, msgAndArgs...)
 }
 
 // Panics asserts that the code inside the specified PanicTestFunc panics.
@@ -162,7 +260,36 @@ func (r *Requirements) Condition(comp assert.Comparison, msgAndArgs ...interface
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) Panics(f assert.PanicTestFunc, msgAndArgs ...interface{}) {
-	Panics(r.t, f, msgAndArgs)
+	Panics(r.t, f, msgAndArgs...)
+}
+
+// PanicsWithValue asserts that the code inside the specified PanicTestFunc panics,
+// and that the recovered panic value equals the expected value.
+//
+//   require.PanicsWithValue("crazy error", func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with \"crazy error\"")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) PanicsWithValue(expected interface{}, f assert.PanicTestFunc, msgAndArgs ...interface{}) {
+	PanicsWithValue(r.t, expected, f, msgAndArgs...)
+}
+
+// PanicsWithError asserts that the code inside the specified PanicTestFunc panics
+// with an error.  When expected is a string, the message of the error must equal
+// it; when expected is an error, the error must match it, as errors.Is does
+// through the chain of wrapped errors.
+//
+//   require.PanicsWithError("crazy error", func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with the \"crazy error\" error")
+//   require.PanicsWithError(ErrCrazy, func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with ErrCrazy")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) PanicsWithError(expected interface{}, f assert.PanicTestFunc, msgAndArgs ...interface{}) {
+	PanicsWithError(r.t, expected, f, msgAndArgs...)
 }
 
 // NotPanics asserts that the code inside the specified PanicTestFunc does NOT panic.
@@ -173,7 +300,7 @@ func (r *Requirements) Panics(f assert.PanicTestFunc, msgAndArgs ...interface{})
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) NotPanics(f assert.PanicTestFunc, msgAndArgs ...interface{}) {
-	NotPanics(r.t, f, msgAndArgs)
+	NotPanics(r.t, f, msgAndArgs...)
 }
 
 // WithinDuration asserts that the two times are within duration delta of each other.
@@ -182,23 +309,79 @@ func (r *Requirements) NotPanics(f assert.PanicTestFunc, msgAndArgs ...interface
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) WithinDuration(expected, actual time.Time, delta time.Duration, msgAndArgs ...interface{}) {
-	WithinDuration(r.t, expected, actual, delta, msgAndArgs)
+	WithinDuration(r.t, expected, actual, delta, msgAndArgs...)
 }
 
 // InDelta asserts that the two numerals are within delta of each other.
+// NaN is not within any delta of anything, and an infinity is only within
+// delta of the same infinity.
 //
 // 	 require.InDelta(math.Pi, (22 / 7.0), 0.01)
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) InDelta(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) {
-	InDelta(r.t, expected, actual, delta, msgAndArgs)
+	InDelta(r.t, expected, actual, delta, msgAndArgs...)
+}
+
+// InDeltaSlice asserts that the two lists (arrays or slices) have the same
+// length, and that their elements at the same index are within delta of
+// each other.
+//
+// 	 require.InDeltaSlice([]float64{math.Pi, math.E}, []float64{3.14, 2.72}, 0.01)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) InDeltaSlice(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) {
+	InDeltaSlice(r.t, expected, actual, delta, msgAndArgs...)
+}
+
+// InDeltaMapValues asserts that the two maps have the same keys, and that
+// their values for the same key are within delta of each other.
+//
+// 	 require.InDeltaMapValues(map[string]float64{"pi": math.Pi}, map[string]float64{"pi": 3.14}, 0.01)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) InDeltaMapValues(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) {
+	InDeltaMapValues(r.t, expected, actual, delta, msgAndArgs...)
 }
 
-// InEpsilon asserts that expected and actual have a relative error less than epsilon
+// InEpsilon asserts that expected and actual have a relative error of at most
+// epsilon, the relative error being |expected - actual| / |expected|.
+// When expected is zero, actual must be zero too.
+//
+// 	 require.InEpsilon(100, 101, 0.01)
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) InEpsilon(expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) {
-	InEpsilon(r.t, expected, actual, epsilon, msgAndArgs)
+	InEpsilon(r.t, expected, actual, epsilon, msgAndArgs...)
+}
+
+// InEpsilonSlice asserts that the two lists (arrays or slices) have the same
+// length, and that their elements at the same index have a relative error of
+// at most epsilon, as defined by InEpsilon.
+//
+// 	 require.InEpsilonSlice([]float64{100, 200}, []float64{101, 198}, 0.01)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) InEpsilonSlice(expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) {
+	InEpsilonSlice(r.t, expected, actual, epsilon, msgAndArgs...)
+}
+
+// IsNaN asserts that the specified number is NaN (not a number).
+//
+// 	 require.IsNaN(math.Sqrt(-1))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) IsNaN(f interface{}, msgAndArgs ...interface{}) {
+	IsNaN(r.t, f, msgAndArgs...)
+}
+
+// NotNaN asserts that the specified number is not NaN (not a number).
+//
+// 	 require.NotNaN(math.Sqrt(2))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) NotNaN(f interface{}, msgAndArgs ...interface{}) {
+	NotNaN(r.t, f, msgAndArgs...)
 }
 
 // NoError asserts that a function returned no error (i.e. `nil`).
@@ -210,7 +393,7 @@ func (r *Requirements) InEpsilon(expected, actual interface{}, epsilon float64,
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) NoError(err error, msgAndArgs ...interface{}) {
-	NoError(r.t, err, msgAndArgs)
+	NoError(r.t, err, msgAndArgs...)
 }
 
 // Error asserts that a function returned an error (i.e. not `nil`).
@@ -222,7 +405,7 @@ func (r *Requirements) NoError(err error, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) Error(err error, msgAndArgs ...interface{}) {
-	Error(r.t, err, msgAndArgs)
+	Error(r.t, err, msgAndArgs...)
 }
 
 // EqualError asserts that a function returned an error (i.e. not `nil`)
@@ -235,25 +418,248 @@ func (r *Requirements) Error(err error, msgAndArgs ...interface{}) {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (r *Requirements) EqualError(theError error, errString string, msgAndArgs ...interface{}) {
-	EqualError(r.t, theError, errString, msgAndArgs)
+	EqualError(r.t, theError, errString, msgAndArgs...)
 }
 
-// Regexp asserts that a specified regexp matches a string.
+// Regexp asserts that a specified regexp matches a string.  The regexp is
+// either a *regexp.Regexp or a pattern, compiled once and cached.
 //
 //  require.Regexp(regexp.MustCompile("start"), "it's starting")
 //  require.Regexp("start...$", "it's not starting")
 //
 // Returns whether the assertion was successful (true) or not (false).
-func (r *Requirements) Regexp(rx interface{}, str interface{}) {
-	Regexp(r.t, rx, str)
+func (r *Requirements) Regexp(rx interface{}, str interface{}, msgAndArgs ...interface{}) {
+	Regexp(r.t, rx, str, msgAndArgs...)
 }
 
-// NotRegexp asserts that a specified regexp does not match a string.
+// NotRegexp asserts that a specified regexp does not match a string.  The
+// regexp is either a *regexp.Regexp or a pattern, compiled once and cached.
 //
 //  require.NotRegexp(regexp.MustCompile("starts"), "it's starting")
 //  require.NotRegexp("^start", "it's not starting")
 //
 // Returns whether the assertion was successful (true) or not (false).
-func (r *Requirements) NotRegexp(rx interface{}, str interface{}) {
-	NotRegexp(r.t, rx, str)
+func (r *Requirements) NotRegexp(rx interface{}, str interface{}, msgAndArgs ...interface{}) {
+	NotRegexp(r.t, rx, str, msgAndArgs...)
+}
+
+// RegexpCaptures asserts that a specified regexp matches a string, and returns
+// the groups captured by its leftmost match.  The regexp is either a
+// *regexp.Regexp or a pattern, compiled once and cached.
+//
+//  require.RegexpCaptures(`(\w+)@(\w+)\.com`, "bob@example.com") // []string{"bob", "example"}
+//
+// Returns the captured groups, and whether the assertion was successful (true) or not (false).
+func (r *Requirements) RegexpCaptures(rx interface{}, str interface{}, msgAndArgs ...interface{}) []string {
+	return RegexpCaptures(r.t, rx, str, msgAndArgs...)
+}
+
+// Greater asserts that the first element is greater than the second.
+//
+//	require.Greater(2, 1)
+//	require.Greater(float64(2), float32(1))
+//	require.Greater("b", "a")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) Greater(e1, e2 interface{}, msgAndArgs ...interface{}) {
+	Greater(r.t, e1, e2, msgAndArgs...)
+}
+
+// GreaterOrEqual asserts that the first element is greater than or equal to the second.
+//
+//	require.GreaterOrEqual(2, 1)
+//	require.GreaterOrEqual(2, 2)
+//	require.GreaterOrEqual("b", "a")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) GreaterOrEqual(e1, e2 interface{}, msgAndArgs ...interface{}) {
+	GreaterOrEqual(r.t, e1, e2, msgAndArgs...)
+}
+
+// Less asserts that the first element is less than the second.
+//
+//	require.Less(1, 2)
+//	require.Less(float64(1), float32(2))
+//	require.Less("a", "b")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) Less(e1, e2 interface{}, msgAndArgs ...interface{}) {
+	Less(r.t, e1, e2, msgAndArgs...)
+}
+
+// LessOrEqual asserts that the first element is less than or equal to the second.
+//
+//	require.LessOrEqual(1, 2)
+//	require.LessOrEqual(2, 2)
+//	require.LessOrEqual("a", "b")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) LessOrEqual(e1, e2 interface{}, msgAndArgs ...interface{}) {
+	LessOrEqual(r.t, e1, e2, msgAndArgs...)
+}
+
+// Positive asserts that the specified number is greater than zero.
+//
+//	require.Positive(1)
+//	require.Positive(time.Second)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) Positive(e interface{}, msgAndArgs ...interface{}) {
+	Positive(r.t, e, msgAndArgs...)
+}
+
+// Negative asserts that the specified number is less than zero.
+//
+//	require.Negative(-1)
+//	require.Negative(-time.Second)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) Negative(e interface{}, msgAndArgs ...interface{}) {
+	Negative(r.t, e, msgAndArgs...)
+}
+
+// IsIncreasing asserts that each element of the list is greater than the previous one.
+//
+//	require.IsIncreasing([]int{1, 2, 3})
+//	require.IsIncreasing([]string{"a", "b"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) IsIncreasing(list interface{}, msgAndArgs ...interface{}) {
+	IsIncreasing(r.t, list, msgAndArgs...)
+}
+
+// IsNonIncreasing asserts that each element of the list is less than or equal to the previous one.
+//
+//	require.IsNonIncreasing([]int{2, 1, 1})
+//	require.IsNonIncreasing([]string{"b", "a"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) IsNonIncreasing(list interface{}, msgAndArgs ...interface{}) {
+	IsNonIncreasing(r.t, list, msgAndArgs...)
+}
+
+// IsDecreasing asserts that each element of the list is less than the previous one.
+//
+//	require.IsDecreasing([]int{3, 2, 1})
+//	require.IsDecreasing([]string{"b", "a"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) IsDecreasing(list interface{}, msgAndArgs ...interface{}) {
+	IsDecreasing(r.t, list, msgAndArgs...)
+}
+
+// IsNonDecreasing asserts that each element of the list is greater than or equal to the previous one.
+//
+//	require.IsNonDecreasing([]int{1, 1, 2})
+//	require.IsNonDecreasing([]string{"a", "b"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) IsNonDecreasing(list interface{}, msgAndArgs ...interface{}) {
+	IsNonDecreasing(r.t, list, msgAndArgs...)
+}
+
+// Sorted asserts that the list (array or slice) is sorted according to less,
+// which reports whether the element at index i must sort before the element
+// at index j, as with sort.Slice.
+//
+//	require.Sorted(people, func(i, j int) bool { return people[i].Age < people[j].Age })
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) Sorted(list interface{}, less assert.LessFunc, msgAndArgs ...interface{}) {
+	Sorted(r.t, list, less, msgAndArgs...)
+}
+
+// FileExists asserts that the specified path exists and is not a directory.
+// Symbolic links are followed.
+//
+//	require.FileExists("testdata/config.json")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) FileExists(path string, msgAndArgs ...interface{}) {
+	FileExists(r.t, path, msgAndArgs...)
+}
+
+// NoFileExists asserts that the specified path does not exist, or is a
+// directory.  Symbolic links are followed.
+//
+//	require.NoFileExists("testdata/output.json")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) NoFileExists(path string, msgAndArgs ...interface{}) {
+	NoFileExists(r.t, path, msgAndArgs...)
+}
+
+// DirExists asserts that the specified path exists and is a directory.
+// Symbolic links are followed.
+//
+//	require.DirExists("testdata")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) DirExists(path string, msgAndArgs ...interface{}) {
+	DirExists(r.t, path, msgAndArgs...)
+}
+
+// NoDirExists asserts that the specified path does not exist, or is not a
+// directory.  Symbolic links are followed.
+//
+//	require.NoDirExists("testdata/tmp")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) NoDirExists(path string, msgAndArgs ...interface{}) {
+	NoDirExists(r.t, path, msgAndArgs...)
+}
+
+// FileContains asserts that the content of the file at the specified path
+// contains the specified substring.
+//
+//	require.FileContains("testdata/config.json", `"debug": true`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) FileContains(path string, contains string, msgAndArgs ...interface{}) {
+	FileContains(r.t, path, contains, msgAndArgs...)
+}
+
+// FileEquals asserts that the content of the file at the specified path is
+// equal to expected, a string or a []byte, and shows how they differ when
+// it is not.
+//
+//	require.FileEquals("out/report.txt", "total: 3\n")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) FileEquals(path string, expected interface{}, msgAndArgs ...interface{}) {
+	FileEquals(r.t, path, expected, msgAndArgs...)
+}
+
+// FileMode asserts that the file or directory at the specified path has the
+// specified permission bits.  The type bits of the file, such as os.ModeDir,
+// are only compared when mode has some.  Symbolic links are followed.
+//
+//	require.FileMode("bin/run.sh", 0755)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) FileMode(path string, mode os.FileMode, msgAndArgs ...interface{}) {
+	FileMode(r.t, path, mode, msgAndArgs...)
+}
+
+// Golden asserts that actual, a string or a []byte, is equal to the
+// content of the golden file testdata/<name>.golden.  When the tests
+// are run with the -testify.update flag, the golden file is written
+// with actual instead.
+//
+//	require.Golden("render/index.html", renderIndex())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) Golden(name string, actual interface{}, msgAndArgs ...interface{}) {
+	Golden(r.t, name, actual, msgAndArgs...)
+}
+
+// GoldenText asserts like Golden that actual is equal to the content
+// of the golden file testdata/<name>.golden, but ignores differences
+// between "\r\n", "\r" and "\n" line endings.
+//
+//	require.GoldenText("report.txt", report.String())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (r *Requirements) GoldenText(name string, actual interface{}, msgAndArgs ...interface{}) {
+	GoldenText(r.t, name, actual, msgAndArgs...)
 }
@@ -0,0 +1,99 @@
+package suite
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// RunBenchmarks takes a benchmarking suite and runs all of the
+// methods attached to it whose names begin with "Benchmark", each as
+// a sub-benchmark of b.  Benchmark methods read the number of
+// iterations to run from B().N.
+//
+// SetupSuite and TearDownSuite run once around all of the benchmarks.
+// SetupTest, BeforeTest, AfterTest and TearDownTest run around every
+// call to a benchmark method, outside of the benchmark's timer.
+// Fixtures and the functions registered with Cleanup and CleanupSuite
+// are set up and run as they are by Run.  Hooks shared by tests and
+// benchmarks should use the suite's TB() to get at the current
+// context, as T() fails the benchmark.
+func RunBenchmarks(b *testing.B, suite BenchmarkingSuite) {
+	if setter, ok := suite.(suiteSetter); ok {
+		setter.setS(suite)
+	}
+	suite.SetB(b)
+
+	var teardowns teardownStack
+	defer func() {
+		if tearDownAllSuite, ok := suite.(TearDownAllSuite); ok {
+			callHook(b, "TearDownSuite", tearDownAllSuite.TearDownSuite)
+		}
+		teardowns.run(b, "cleanup")
+	}()
+	if setter, ok := suite.(cleanupSetter); ok {
+		setter.setSuiteCleanups(&teardowns)
+	}
+	if !injectFixtures(b, suite, SuiteScope, &teardowns) {
+		return
+	}
+	if setupAllSuite, ok := suite.(SetupAllSuite); ok {
+		if !callHook(b, "SetupSuite", setupAllSuite.SetupSuite) {
+			return
+		}
+	}
+
+	methodFinder := reflect.TypeOf(suite)
+	suiteName := getSuiteName(methodFinder)
+	for index := 0; index < methodFinder.NumMethod(); index++ {
+		method := methodFinder.Method(index)
+		if !strings.HasPrefix(method.Name, "Benchmark") {
+			continue
+		}
+		b.Run(method.Name, func(b *testing.B) {
+			parentB := suite.B()
+			suite.SetB(b)
+			if setter, ok := suite.(testNameSetter); ok {
+				setter.setTestName(method.Name)
+				defer setter.setTestName("")
+			}
+			var teardowns teardownStack
+			if setter, ok := suite.(cleanupSetter); ok {
+				setter.setTestCleanups(&teardowns)
+				defer setter.setTestCleanups(nil)
+			}
+			defer func() {
+				b.StopTimer()
+				if afterTestSuite, ok := suite.(AfterTest); ok {
+					callHook(b, "AfterTest", func() {
+						afterTestSuite.AfterTest(suiteName, method.Name)
+					})
+				}
+				if tearDownTestSuite, ok := suite.(TearDownTestSuite); ok {
+					callHook(b, "TearDownTest", tearDownTestSuite.TearDownTest)
+				}
+				teardowns.run(b, "cleanup")
+				suite.SetB(parentB)
+			}()
+			if !injectFixtures(b, suite, TestScope, &teardowns) {
+				return
+			}
+			if setupTestSuite, ok := suite.(SetupTestSuite); ok {
+				if !callHook(b, "SetupTest", setupTestSuite.SetupTest) {
+					return
+				}
+			}
+			if beforeTestSuite, ok := suite.(BeforeTest); ok {
+				if !callHook(b, "BeforeTest", func() {
+					beforeTestSuite.BeforeTest(suiteName, method.Name)
+				}) {
+					return
+				}
+			}
+			b.ResetTimer()
+			callHook(b, method.Name, func() {
+				method.Func.Call([]reflect.Value{reflect.ValueOf(suite)})
+			})
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package suite
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// SuiteBenchmarker is a suite with benchmarks as well as tests, which
+// counts how often each hook is run.
+type SuiteBenchmarker struct {
+	Suite
+
+	SetupSuiteRunCount    int
+	TearDownSuiteRunCount int
+	SetupTestRunCount     int
+	TearDownTestRunCount  int
+	BenchmarkRunCount     int
+	TestRunCount          int
+
+	Sum int
+}
+
+func (suite *SuiteBenchmarker) SetupSuite() {
+	suite.SetupSuiteRunCount++
+}
+
+func (suite *SuiteBenchmarker) TearDownSuite() {
+	suite.TearDownSuiteRunCount++
+}
+
+func (suite *SuiteBenchmarker) SetupTest() {
+	suite.SetupTestRunCount++
+	suite.Sum = 0
+	suite.NotNil(suite.TB())
+}
+
+func (suite *SuiteBenchmarker) TearDownTest() {
+	suite.TearDownTestRunCount++
+}
+
+func (suite *SuiteBenchmarker) BenchmarkSum() {
+	suite.BenchmarkRunCount++
+	for i := 0; i < suite.B().N; i++ {
+		suite.Sum += i
+	}
+}
+
+func (suite *SuiteBenchmarker) TestSum() {
+	suite.TestRunCount++
+	suite.Equal(0, suite.Sum)
+}
+
+func BenchmarkSuite(b *testing.B) {
+	RunBenchmarks(b, new(SuiteBenchmarker))
+}
+
+func TestRunBenchmarks(t *testing.T) {
+	suiteBenchmarker := new(SuiteBenchmarker)
+	testing.Benchmark(func(b *testing.B) {
+		RunBenchmarks(b, suiteBenchmarker)
+	})
+
+	assert.Equal(t, 1, suiteBenchmarker.SetupSuiteRunCount)
+	assert.Equal(t, 1, suiteBenchmarker.TearDownSuiteRunCount)
+	assert.Equal(t, 0, suiteBenchmarker.TestRunCount)
+
+	// The benchmark is called once per round of b.N, with the test
+	// hooks around every call.
+	assert.True(t, suiteBenchmarker.BenchmarkRunCount > 0)
+	assert.Equal(t, suiteBenchmarker.BenchmarkRunCount, suiteBenchmarker.SetupTestRunCount)
+	assert.Equal(t, suiteBenchmarker.BenchmarkRunCount, suiteBenchmarker.TearDownTestRunCount)
+
+	// Running the suite as tests leaves the benchmarks out.
+	Run(t, suiteBenchmarker)
+	assert.Equal(t, 1, suiteBenchmarker.TestRunCount)
+}
+
+func init() {
+	RegisterFixture("buffer", TestScope, func(tb testing.TB) *bytes.Buffer {
+		return bytes.NewBufferString("buffer for " + tb.Name())
+	})
+}
+
+// SuiteBenchmarkHooksTester is a benchmarking suite using fixtures,
+// cleanups and subtests, which records what it runs in Events.
+type SuiteBenchmarkHooksTester struct {
+	Suite
+
+	Buffer *bytes.Buffer `fixture:"buffer"`
+
+	// CallT has SetupSuite call T(), as hooks written for tests do.
+	CallT  bool
+	Events []string
+}
+
+func (suite *SuiteBenchmarkHooksTester) SetupSuite() {
+	suite.CleanupSuite(func() {
+		suite.Events = append(suite.Events, "suite cleanup")
+	})
+	if suite.CallT {
+		suite.T()
+		suite.Events = append(suite.Events, "T() returned")
+	}
+}
+
+func (suite *SuiteBenchmarkHooksTester) TearDownSuite() {
+	suite.Events = append(suite.Events, "TearDownSuite")
+}
+
+func (suite *SuiteBenchmarkHooksTester) TearDownTest() {
+	suite.Events = append(suite.Events, "TearDownTest")
+}
+
+func (suite *SuiteBenchmarkHooksTester) BenchmarkHooks() {
+	suite.Cleanup(func() {
+		suite.Events = append(suite.Events, "cleanup")
+	})
+	suite.Events = append(suite.Events, suite.Buffer.String())
+	parentB := suite.B()
+	subRan := false
+	suite.Run("sub", func() {
+		if !subRan && suite.B() != parentB {
+			suite.Events = append(suite.Events, "sub")
+		}
+		subRan = true
+	})
+}
+
+func TestRunBenchmarksHooks(t *testing.T) {
+	suiteTester := new(SuiteBenchmarkHooksTester)
+	testing.Benchmark(func(b *testing.B) {
+		RunBenchmarks(b, suiteTester)
+	})
+
+	// The sub-benchmark makes BenchmarkHooks run only once.
+	if assert.Len(t, suiteTester.Events, 6) {
+		assert.True(t, strings.HasPrefix(suiteTester.Events[0], "buffer for "))
+		assert.Equal(t, []string{"sub", "TearDownTest", "cleanup", "TearDownSuite", "suite cleanup"}, suiteTester.Events[1:])
+	}
+}
+
+func TestRunBenchmarksCallingT(t *testing.T) {
+	suiteTester := &SuiteBenchmarkHooksTester{CallT: true}
+	testing.Benchmark(func(b *testing.B) {
+		RunBenchmarks(b, suiteTester)
+	})
+
+	// T() stopped the benchmarks rather than returning nil, and the
+	// suite was still torn down.
+	assert.Equal(t, []string{"TearDownSuite", "suite cleanup"}, suiteTester.Events)
+}
@@ -23,10 +23,67 @@
 // identity that "go test" is already looking for (i.e.
 // func(*testing.T)).
 //
-// Regular expression to select test suites specified command-line
-// argument "-run". Regular expression to select the methods
-// of test suites specified command-line argument "-m".
-// Suite object has assertion methods.
+// Each test method is run as a subtest of the test function that
+// called suite.Run, so "go test -run TestExampleTestSuite/TestExample"
+// selects a single method of a suite.  The command-line argument "-m"
+// is still accepted as a regular expression to select methods of all
+// suites.
+// Suite object has assertion methods, and its Require method returns
+// the same assertions from the require package, which stop the test
+// method when they fail.
+//
+// Methods that begin with "Benchmark" are run as benchmarks when the
+// suite is passed to suite.RunBenchmarks from a func(*testing.B).
+// Suite object provides B() for the current *testing.B, and TB() for
+// whichever of the test or benchmark context is current; hooks shared
+// by tests and benchmarks should use TB(), as T() fails a benchmark.
+//
+// Inside a test method, use the suite's Run method rather than
+// T().Run to start subtests, so that T() and the assertion methods
+// refer to the subtest while it runs.
+//
+// Resources shared by many suites, such as temporary directories or
+// test servers, can be declared once with suite.RegisterFixture.  Run
+// then sets them up for each suite or test, injects them into the
+// exported fields of the suite tagged with their names, as in
+// `fixture:"tempdir"`, and tears them down after TearDownSuite or
+// TearDownTest.
+//
+// Prefixing a test method with "X" (as in XTestSomething) disables
+// it, and it is reported as skipped.  Prefixing it with "F" (as in
+// FTestSomething) focuses it: when a suite has focused methods, only
+// those are run.  Focused methods are meant for local debugging only,
+// and fail the suite when the CI environment variable is set.
+//
+// Suites implementing TaggedSuite can tag their methods, and the
+// command-line arguments "-testify.tags" and "-testify.exclude-tags"
+// take comma-separated lists of tags to select methods by.
+//
+// The command-line argument "-testify.timeout" limits how long each
+// test method may run; suites can set their own limits by implementing
+// TimeoutSuite or MethodTimeoutSuite.  A method that runs for too long
+// fails with its stack, its TearDownTest method and cleanups run, and
+// the suite moves on to the next method.  The method itself is left
+// running, and whatever it reports through the suite is dropped once
+// the suite is done.
+//
+// Flaky test methods, selected by implementing RetrySuite or by
+// tagging them "flaky", are run up to a number of attempts before
+// their failure is reported.  Every attempt but the last reports to a
+// recorder rather than to the test, so its failures are logged on the
+// test without failing it, and the suite prints which methods needed
+// more than one attempt, which shows even without -v.
+//
+// To run the tests of a suite in parallel, use suite.RunParallel
+// instead of suite.Run.  Every test then gets its own shallow copy of
+// the suite, so fields set in SetupTest are private to that test,
+// while values set up in SetupSuite (and anything shared through
+// pointers) are seen by all of them.
+//
+// The suite's GoldenFile and GoldenTextFile methods compare a value
+// with a golden file named after the suite and the current test, as in
+// testdata/ExampleTestSuite/TestExample.golden.  Running the tests
+// with "-testify.update" rewrites the golden files instead.
 //
 // A crude example:
 //     // Basic imports
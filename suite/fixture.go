@@ -0,0 +1,177 @@
+package suite
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// FixtureScope says how long a fixture lives.
+type FixtureScope int
+
+const (
+	// SuiteScope fixtures are set up once per suite, before its
+	// SetupSuite method, and torn down after its TearDownSuite
+	// method.
+	SuiteScope FixtureScope = iota
+	// TestScope fixtures are set up before the SetupTest method of
+	// every test, and torn down after its TearDownTest method.
+	TestScope
+)
+
+// fixture is a fixture declared with RegisterFixture.
+type fixture struct {
+	name  string
+	scope FixtureScope
+	setup reflect.Value
+	// valueType is the type of the values setup returns.
+	valueType reflect.Type
+}
+
+var (
+	testingTType  = reflect.TypeOf((*testing.T)(nil))
+	testingTBType = reflect.TypeOf((*testing.TB)(nil)).Elem()
+	teardownType  = reflect.TypeOf(func() {})
+
+	fixturesMutex sync.Mutex
+	fixtures      []*fixture
+)
+
+// RegisterFixture declares a fixture called name, which Run and
+// RunBenchmarks set up and inject into the suites that want it.  setup
+// must be a func taking the *testing.T, or the testing.TB, of the
+// suite or test the fixture is set up for, and returning the fixture,
+// optionally followed by a func() tearing it down:
+//
+//	suite.RegisterFixture("tempdir", suite.TestScope, func(t *testing.T) (string, func()) {
+//	    dir, err := ioutil.TempDir("", "example")
+//	    if err != nil {
+//	        t.Fatal(err)
+//	    }
+//	    return dir, func() { os.RemoveAll(dir) }
+//	})
+//
+// A fixture is injected into the exported fields of a suite tagged
+// with its name, as in `fixture:"tempdir"`; fields without the tag are
+// left alone.  Fixtures are torn down in the reverse order they were
+// set up.  Benchmarks have no *testing.T, so only fixtures set up from
+// a testing.TB can be injected into them.
+//
+// RegisterFixture is meant to be called from init functions, and
+// panics if setup is not a valid fixture setup function or if name is
+// already taken.
+func RegisterFixture(name string, scope FixtureScope, setup interface{}) {
+	setupValue := reflect.ValueOf(setup)
+	setupType := setupValue.Type()
+	if setupType.Kind() != reflect.Func || setupType.NumIn() != 1 ||
+		(setupType.In(0) != testingTType && setupType.In(0) != testingTBType) ||
+		setupType.NumOut() < 1 || setupType.NumOut() > 2 ||
+		(setupType.NumOut() == 2 && setupType.Out(1) != teardownType) {
+		panic(fmt.Sprintf("testify: fixture %q must be set up by a func(*testing.T) T or func(*testing.T) (T, func()), or the same taking a testing.TB, not %T", name, setup))
+	}
+
+	fixturesMutex.Lock()
+	defer fixturesMutex.Unlock()
+
+	for _, f := range fixtures {
+		if f.name == name {
+			panic(fmt.Sprintf("testify: fixture %q is already registered", name))
+		}
+	}
+	fixtures = append(fixtures, &fixture{
+		name:      name,
+		scope:     scope,
+		setup:     setupValue,
+		valueType: setupType.Out(0),
+	})
+}
+
+// findFixture returns the fixture to inject into the struct field, or
+// nil if the field is not tagged with the name of one.
+func findFixture(field reflect.StructField) (*fixture, error) {
+	name, tagged := field.Tag.Lookup("fixture")
+	if !tagged {
+		return nil, nil
+	}
+
+	fixturesMutex.Lock()
+	defer fixturesMutex.Unlock()
+
+	for _, f := range fixtures {
+		if f.name == name {
+			if !f.valueType.AssignableTo(field.Type) {
+				return nil, fmt.Errorf("fixture %q of type %v cannot be assigned to field %s of type %v", name, f.valueType, field.Name, field.Type)
+			}
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("no fixture %q is registered for field %s", name, field.Name)
+}
+
+// injectFixtures sets up the fixtures of the given scope that suite
+// has fields for, on tb, and sets the fields.  The teardowns of the
+// fixtures are pushed onto teardowns.  It returns false if setting up
+// a fixture failed.
+func injectFixtures(tb testing.TB, suite interface{}, scope FixtureScope, teardowns *teardownStack) bool {
+	suiteValue := reflect.ValueOf(suite)
+	if suiteValue.Kind() != reflect.Ptr || suiteValue.Elem().Kind() != reflect.Struct {
+		return true
+	}
+	suiteValue = suiteValue.Elem()
+
+	for index := 0; index < suiteValue.NumField(); index++ {
+		field := suiteValue.Type().Field(index)
+		if field.PkgPath != "" || field.Anonymous {
+			continue
+		}
+		f, err := findFixture(field)
+		if err != nil {
+			tb.Errorf("testify: %s: %s", getSuiteName(suiteValue.Type()), err)
+			return false
+		}
+		if f == nil || f.scope != scope {
+			continue
+		}
+		arg := reflect.ValueOf(tb)
+		if recorder, ok := tb.(*recordingTB); ok && f.setup.Type().In(0) == testingTType {
+			// An earlier attempt at a retried method has only its
+			// test's own *testing.T to give.
+			arg = reflect.ValueOf(recorder.TB)
+		}
+		if !arg.Type().AssignableTo(f.setup.Type().In(0)) {
+			tb.Errorf("testify: %s: fixture %q is set up from a *testing.T, which %s does not have", getSuiteName(suiteValue.Type()), f.name, tb.Name())
+			return false
+		}
+
+		ok := callHook(tb, "fixture "+f.name, func() {
+			out := f.setup.Call([]reflect.Value{arg})
+			suiteValue.Field(index).Set(out[0])
+			if len(out) == 2 && !out[1].IsNil() {
+				teardowns.push(out[1].Interface().(func()))
+			}
+		})
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// teardownStack holds the functions to run once a suite or a test is
+// done, last in first out.
+type teardownStack []func()
+
+func (s *teardownStack) push(f func()) {
+	*s = append(*s, f)
+}
+
+// run calls the functions on the stack, last pushed first, reporting
+// their panics as failures of tb under name, and empties the stack.
+func (s *teardownStack) run(tb testing.TB, name string) {
+	for len(*s) > 0 {
+		f := (*s)[len(*s)-1]
+		*s = (*s)[:len(*s)-1]
+		callHook(tb, name, f)
+	}
+}
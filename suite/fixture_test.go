@@ -0,0 +1,132 @@
+package suite
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fixtureDB struct {
+	Name string
+}
+
+// fixtureEvents records the setup and teardown of fixtures, along with
+// the hooks of SuiteFixtureTester, in the order they happen.
+var fixtureEvents []string
+
+func init() {
+	RegisterFixture("db", SuiteScope, func(t *testing.T) (*fixtureDB, func()) {
+		fixtureEvents = append(fixtureEvents, "setup db")
+		return &fixtureDB{Name: t.Name()}, func() {
+			fixtureEvents = append(fixtureEvents, "teardown db")
+		}
+	})
+	RegisterFixture("tempdir", TestScope, func(t *testing.T) (string, func()) {
+		fixtureEvents = append(fixtureEvents, "setup tempdir")
+		return "dir for " + t.Name(), func() {
+			fixtureEvents = append(fixtureEvents, "teardown tempdir")
+		}
+	})
+	RegisterFixture("port", TestScope, func(t *testing.T) (int, func()) {
+		fixtureEvents = append(fixtureEvents, "setup port")
+		return 8080, func() {
+			fixtureEvents = append(fixtureEvents, "teardown port")
+		}
+	})
+}
+
+type SuiteFixtureTester struct {
+	Suite
+
+	DB      *fixtureDB `fixture:"db"`
+	Dir     string     `fixture:"tempdir"`
+	Port    int        `fixture:"port"`
+	OwnDB   *fixtureDB
+	Ignored string
+}
+
+func (suite *SuiteFixtureTester) SetupSuite() {
+	fixtureEvents = append(fixtureEvents, "SetupSuite with "+suite.DB.Name)
+}
+
+func (suite *SuiteFixtureTester) TearDownSuite() {
+	fixtureEvents = append(fixtureEvents, "TearDownSuite")
+}
+
+func (suite *SuiteFixtureTester) SetupTest() {
+	fixtureEvents = append(fixtureEvents, "SetupTest with "+suite.Dir)
+}
+
+func (suite *SuiteFixtureTester) TearDownTest() {
+	fixtureEvents = append(fixtureEvents, "TearDownTest")
+}
+
+func (suite *SuiteFixtureTester) TestOne() {
+	fixtureEvents = append(fixtureEvents, fmt.Sprintf("TestOne on port %d", suite.Port))
+	suite.Nil(suite.OwnDB)
+	suite.Empty(suite.Ignored)
+}
+
+func TestSuiteFixtures(t *testing.T) {
+	fixtureEvents = nil
+	Run(t, new(SuiteFixtureTester))
+
+	assert.Equal(t, []string{
+		"setup db",
+		"SetupSuite with TestSuiteFixtures",
+		"setup tempdir",
+		"setup port",
+		"SetupTest with dir for TestSuiteFixtures/TestOne",
+		"TestOne on port 8080",
+		"TearDownTest",
+		"teardown port",
+		"teardown tempdir",
+		"TearDownSuite",
+		"teardown db",
+	}, fixtureEvents)
+}
+
+type SuiteMissingFixtureTester struct {
+	Suite
+
+	Missing string `fixture:"missing"`
+
+	TestRunCount int
+}
+
+func (suite *SuiteMissingFixtureTester) TestOne() {
+	suite.TestRunCount++
+}
+
+func TestSuiteMissingFixture(t *testing.T) {
+	suiteTester := new(SuiteMissingFixtureTester)
+	ok, output := runCaptured(t, suiteTester)
+
+	assert.False(t, ok)
+	assert.Contains(t, output, `testify: SuiteMissingFixtureTester: no fixture "missing" is registered for field Missing`)
+	assert.Equal(t, 0, suiteTester.TestRunCount)
+}
+
+func TestRegisterFixture(t *testing.T) {
+	assert.Panics(t, func() {
+		RegisterFixture("nothing", TestScope, func() int { return 0 })
+	})
+	assert.Panics(t, func() {
+		RegisterFixture("wrong teardown", TestScope, func(*testing.T) (int, error) { return 0, nil })
+	})
+	assert.Panics(t, func() {
+		RegisterFixture("db", TestScope, func(*testing.T) *fixtureDB { return nil })
+	})
+}
+
+func TestSuiteFixturesInBenchmarks(t *testing.T) {
+	fixtureEvents = nil
+	testing.Benchmark(func(b *testing.B) {
+		RunBenchmarks(b, new(SuiteFixtureTester))
+	})
+
+	// The db fixture is set up from a *testing.T, which the benchmarks
+	// do not have, so the suite was not set up.
+	assert.Equal(t, []string{"TearDownSuite"}, fixtureEvents)
+}
@@ -1,6 +1,9 @@
 package suite
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 // TestingSuite can store and return the current *testing.T context
 // generated by 'go test'.
@@ -9,6 +12,13 @@ type TestingSuite interface {
 	SetT(*testing.T)
 }
 
+// BenchmarkingSuite can store and return the current *testing.B
+// context generated by 'go test -bench'.
+type BenchmarkingSuite interface {
+	B() *testing.B
+	SetB(*testing.B)
+}
+
 // SetupAllSuite has a SetupSuite method, which will run before the
 // tests in the suite are run.
 type SetupAllSuite interface {
@@ -32,3 +42,63 @@ type TearDownAllSuite interface {
 type TearDownTestSuite interface {
 	TearDownTest()
 }
+
+// SetupSubTest has a SetupSubTest method, which will run before each
+// subtest started with Suite.Run.
+type SetupSubTest interface {
+	SetupSubTest()
+}
+
+// TearDownSubTest has a TearDownSubTest method, which will run after
+// each subtest started with Suite.Run.
+type TearDownSubTest interface {
+	TearDownSubTest()
+}
+
+// BeforeTest has a function to be executed right before the test
+// starts and receives the suite and test names as input
+type BeforeTest interface {
+	BeforeTest(suiteName, testName string)
+}
+
+// AfterTest has a function to be executed right after the test
+// finishes and receives the suite and test names as input
+type AfterTest interface {
+	AfterTest(suiteName, testName string)
+}
+
+// WithStats has a HandleStats method, which will run after the
+// TearDownSuite method, and receives the name of the suite and stats
+// on how each of its tests ran.
+type WithStats interface {
+	HandleStats(suiteName string, stats *SuiteStats)
+}
+
+// TaggedSuite has a Tags method, which returns the tags of the test
+// methods of the suite, by method name.  The command-line arguments
+// -testify.tags and -testify.exclude-tags select methods by tag.
+type TaggedSuite interface {
+	Tags() map[string][]string
+}
+
+// TimeoutSuite has a Timeout method, which returns how long each test
+// method of the suite may run before it fails.  It overrides the
+// -testify.timeout command-line argument.
+type TimeoutSuite interface {
+	Timeout() time.Duration
+}
+
+// MethodTimeoutSuite has a MethodTimeout method, which returns how
+// long the test method called testName may run before it fails, or 0
+// to fall back to the timeout of the suite.
+type MethodTimeoutSuite interface {
+	MethodTimeout(testName string) time.Duration
+}
+
+// RetrySuite has a MaxAttempts method, which returns how many times
+// the test method called testName is run before its failure is
+// reported, or 0 to go by its tags.  Failed attempts other than the
+// last are logged rather than failing the test.
+type RetrySuite interface {
+	MaxAttempts(testName string) int
+}
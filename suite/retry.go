@@ -0,0 +1,168 @@
+package suite
+
+import (
+	"flag"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var flakyAttempts = flag.Int("testify.flaky-attempts", 3, "how many times suite methods tagged \"flaky\" are run before they fail")
+
+// maxAttempts returns how many times test may be run before its
+// failure is reported.  RetrySuite takes precedence over the "flaky"
+// tag, which allows the number of attempts set by the
+// -testify.flaky-attempts command-line argument.
+func maxAttempts(suite TestingSuite, test suiteTest) int {
+	if retrySuite, ok := suite.(RetrySuite); ok {
+		if attempts := retrySuite.MaxAttempts(test.name); attempts > 0 {
+			return attempts
+		}
+	}
+	for _, tag := range test.tags {
+		if tag == "flaky" {
+			return *flakyAttempts
+		}
+	}
+	return 1
+}
+
+// runAttempt runs an attempt at test other than the last, like
+// runTest, but on a goroutine of its own and with the suite bound to a
+// recordingTB standing in for t, so that its failures do not fail t.
+// It returns the recordingTB, and false if the method timed out and
+// was left running.
+func runAttempt(t *testing.T, suite TestingSuite, suiteName string, test suiteTest) (*recordingTB, bool) {
+	recorder := &recordingTB{TB: t}
+	completed := true
+	recorder.call(func() {
+		completed = runTest(t, recorder, suite, suiteName, test)
+	})
+	return recorder, completed
+}
+
+// recordingTB stands in for the testing.TB it embeds, recording the
+// failures and logs reported to it rather than passing them on.
+// Methods that stop the test stop the calling goroutine instead, so
+// what reports to a recordingTB should be run with its call method.
+type recordingTB struct {
+	testing.TB
+
+	mutex   sync.Mutex
+	failed  bool
+	skipped bool
+	lines   []string
+}
+
+// call calls f on a goroutine of its own, and waits for it to return
+// or to be stopped by a method of r such as FailNow.
+func (r *recordingTB) call(f func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f()
+	}()
+	<-done
+}
+
+// run calls f with a recordingTB of its own for the subtest called
+// name, like call, and records what was reported to it under name.
+// It returns whether the subtest passed.
+func (r *recordingTB) run(name string, f func(*recordingTB)) bool {
+	sub := &recordingTB{TB: r.TB}
+	sub.call(func() {
+		f(sub)
+	})
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.failed = r.failed || sub.failed
+	for _, line := range sub.lines {
+		r.lines = append(r.lines, name+": "+line)
+	}
+	return !sub.failed
+}
+
+func (r *recordingTB) record(failed bool, line string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.failed = r.failed || failed
+	if line != "" {
+		r.lines = append(r.lines, strings.TrimSuffix(line, "\n"))
+	}
+}
+
+func (r *recordingTB) Error(args ...interface{}) {
+	r.record(true, fmt.Sprintln(args...))
+}
+
+func (r *recordingTB) Errorf(format string, args ...interface{}) {
+	r.record(true, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingTB) Fail() {
+	r.record(true, "")
+}
+
+func (r *recordingTB) FailNow() {
+	r.Fail()
+	runtime.Goexit()
+}
+
+func (r *recordingTB) Failed() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.failed
+}
+
+func (r *recordingTB) Fatal(args ...interface{}) {
+	r.Error(args...)
+	runtime.Goexit()
+}
+
+func (r *recordingTB) Fatalf(format string, args ...interface{}) {
+	r.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Log(args ...interface{}) {
+	r.record(false, fmt.Sprintln(args...))
+}
+
+func (r *recordingTB) Logf(format string, args ...interface{}) {
+	r.record(false, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingTB) Skip(args ...interface{}) {
+	r.Log(args...)
+	r.SkipNow()
+}
+
+func (r *recordingTB) Skipf(format string, args ...interface{}) {
+	r.Logf(format, args...)
+	r.SkipNow()
+}
+
+func (r *recordingTB) SkipNow() {
+	r.mutex.Lock()
+	r.skipped = true
+	r.mutex.Unlock()
+	runtime.Goexit()
+}
+
+func (r *recordingTB) Skipped() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.skipped
+}
+
+// output returns what was reported to r, one line per failure or log.
+func (r *recordingTB) output() string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return strings.Join(r.lines, "\n")
+}
@@ -0,0 +1,136 @@
+package suite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type SuiteRetryTester struct {
+	Suite
+
+	Stats *SuiteStats
+
+	SetupTestRunCount    int
+	TearDownTestRunCount int
+	TestFlakyRunCount    int
+	TestFlakyPassCount   int
+	TestTaggedRunCount   int
+	TestBrokenRunCount   int
+	TestSubTestRunCount  int
+}
+
+func (suite *SuiteRetryTester) MaxAttempts(testName string) int {
+	switch testName {
+	case "TestFlaky":
+		return 5
+	case "TestSubTest":
+		return 2
+	}
+	return 0
+}
+
+func (suite *SuiteRetryTester) Tags() map[string][]string {
+	return map[string][]string{
+		"TestTagged": {"flaky"},
+		"TestBroken": {"flaky"},
+	}
+}
+
+func (suite *SuiteRetryTester) HandleStats(suiteName string, stats *SuiteStats) {
+	suite.Stats = stats
+}
+
+func (suite *SuiteRetryTester) SetupTest() {
+	suite.SetupTestRunCount++
+}
+
+func (suite *SuiteRetryTester) TearDownTest() {
+	suite.TearDownTestRunCount++
+}
+
+// TestFlaky passes on its third attempt.
+func (suite *SuiteRetryTester) TestFlaky() {
+	suite.TestFlakyRunCount++
+	suite.Require().True(suite.TestFlakyRunCount >= 3, "FLAKY ATTEMPT %d", suite.TestFlakyRunCount)
+	suite.TestFlakyPassCount++
+}
+
+// TestSubTest has a subtest that passes on its second attempt.
+func (suite *SuiteRetryTester) TestSubTest() {
+	suite.TestSubTestRunCount++
+	suite.Run("sub", func() {
+		suite.Equal(2, suite.TestSubTestRunCount)
+	})
+}
+
+// TestTagged passes on its second attempt.
+func (suite *SuiteRetryTester) TestTagged() {
+	suite.TestTaggedRunCount++
+	if suite.TestTaggedRunCount < 2 {
+		panic("TAGGED ATTEMPT FAILED")
+	}
+}
+
+func (suite *SuiteRetryTester) TestBroken() {
+	suite.TestBrokenRunCount++
+	suite.Fail("BROKEN ATTEMPT", "%d", suite.TestBrokenRunCount)
+}
+
+func TestSuiteRetry(t *testing.T) {
+	setFlag(t, "testify.flaky-attempts", "2")
+	suiteTester := new(SuiteRetryTester)
+	ok, output := runCaptured(t, suiteTester)
+
+	assert.False(t, ok)
+
+	// Earlier attempts don't fail the test, and are not tests of
+	// their own.
+	assert.Equal(t, 3, suiteTester.TestFlakyRunCount)
+	assert.NotContains(t, output, "--- FAIL: TestSuite/TestFlaky")
+	// FailNow stopped the earlier attempts.
+	assert.Equal(t, 1, suiteTester.TestFlakyPassCount)
+	assert.Equal(t, 2, suiteTester.TestTaggedRunCount)
+	assert.NotContains(t, output, "--- FAIL: TestSuite/TestTagged")
+	assert.Equal(t, 2, suiteTester.TestSubTestRunCount)
+	assert.NotContains(t, output, "--- FAIL: TestSuite/TestSubTest")
+	assert.NotContains(t, output, "attempt_")
+
+	// The failure of the last attempt is the failure of the test, and
+	// what the earlier attempts reported is logged on it.
+	assert.Equal(t, 2, suiteTester.TestBrokenRunCount)
+	assert.Contains(t, output, "--- FAIL: TestSuite/TestBroken ")
+	assert.Contains(t, output, "testify: attempt 1 of 2 of TestBroken failed:")
+	assert.Contains(t, output, "BROKEN ATTEMPT")
+
+	// Every attempt is set up and torn down.
+	assert.Equal(t, 9, suiteTester.SetupTestRunCount)
+	assert.Equal(t, 9, suiteTester.TearDownTestRunCount)
+
+	if assert.NotNil(t, suiteTester.Stats) {
+		assert.Equal(t, 3, suiteTester.Stats.TestStats["TestFlaky"].Attempts)
+		assert.True(t, suiteTester.Stats.TestStats["TestFlaky"].Passed)
+		assert.Equal(t, 2, suiteTester.Stats.TestStats["TestBroken"].Attempts)
+		assert.False(t, suiteTester.Stats.TestStats["TestBroken"].Passed)
+	}
+
+	// The summary shows without -v.
+	assert.Contains(t, output, "testify: tests of SuiteRetryTester that needed more than one attempt: "+
+		"TestBroken failed after 2 attempts, TestFlaky passed after 3 attempts, "+
+		"TestSubTest passed after 2 attempts, TestTagged passed after 2 attempts")
+}
+
+func TestSuiteRetryVerbose(t *testing.T) {
+	setFlag(t, "test.v", "true")
+	suiteTester := new(SuiteRetryTester)
+	_, output := runCaptured(t, suiteTester)
+
+	// What the failed attempts of a method that passed in the end
+	// reported is logged on it.
+	assert.Contains(t, output, "testify: attempt 2 of 5 of TestFlaky failed:")
+	assert.Contains(t, output, "FLAKY ATTEMPT 2")
+	assert.Contains(t, output, "testify: TestFlaky passed on attempt 3 of 5")
+	assert.Contains(t, output, "testify: attempt 1 of 2 of TestSubTest failed:")
+	assert.Contains(t, output, "sub: ")
+	assert.Contains(t, output, "--- PASS: TestSuite/TestSubTest/sub")
+}
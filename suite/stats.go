@@ -0,0 +1,106 @@
+package suite
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SuiteStats holds information about a run of a testing suite, and is
+// passed to the HandleStats method of suites implementing WithStats.
+type SuiteStats struct {
+	Start, End time.Time
+	Duration   time.Duration
+
+	// TestStats holds the stats of every test that was run, by
+	// method name.
+	TestStats map[string]*TestStats
+
+	// mutex guards TestStats while tests are run in parallel.
+	mutex sync.Mutex
+}
+
+// TestStats holds information about the run of a single test method
+// of a suite.  Start and End include the SetupTest and TearDownTest
+// hooks of the suite.
+type TestStats struct {
+	TestName   string
+	Start, End time.Time
+	Duration   time.Duration
+	Passed     bool
+
+	// Attempts is how many times the test was run, which is more
+	// than one for a flaky test that was retried.
+	Attempts int
+}
+
+func newSuiteStats() *SuiteStats {
+	return &SuiteStats{
+		Start:     time.Now(),
+		TestStats: make(map[string]*TestStats),
+	}
+}
+
+// Passed reports whether all of the tests of the suite passed.
+func (s *SuiteStats) Passed() bool {
+	for _, stats := range s.TestStats {
+		if !stats.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SuiteStats) start(testName string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.TestStats[testName] = &TestStats{
+		TestName: testName,
+		Start:    time.Now(),
+	}
+}
+
+func (s *SuiteStats) end(testName string, passed bool, attempts int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stats, ok := s.TestStats[testName]
+	if !ok {
+		return
+	}
+	stats.End = time.Now()
+	stats.Duration = stats.End.Sub(stats.Start)
+	stats.Passed = passed
+	stats.Attempts = attempts
+}
+
+func (s *SuiteStats) finish() {
+	s.End = time.Now()
+	s.Duration = s.End.Sub(s.Start)
+}
+
+// flakySummary lists the tests that needed more than one attempt, and
+// whether they passed in the end.
+func (s *SuiteStats) flakySummary() string {
+	var names []string
+	for name, stats := range s.TestStats {
+		if stats.Attempts > 1 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	summary := make([]string, len(names))
+	for i, name := range names {
+		stats := s.TestStats[name]
+		outcome := "passed"
+		if !stats.Passed {
+			outcome = "failed"
+		}
+		summary[i] = fmt.Sprintf("%s %s after %d attempts", name, outcome, stats.Attempts)
+	}
+	return strings.Join(summary, ", ")
+}
@@ -6,87 +6,584 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"runtime/debug"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-var matchMethod = flag.String("m", "", "regular expression to select tests of the suite to run")
+var (
+	matchMethod = flag.String("m", "", "regular expression to select tests of the suite to run (same as -run TestSuite/regexp)")
+	includeTags = flag.String("testify.tags", "", "comma-separated list of tags, only suite methods with one of them are run")
+	excludeTags = flag.String("testify.exclude-tags", "", "comma-separated list of tags, suite methods with any of them are not run")
+)
 
 // Suite is a basic testing suite with methods for storing and
-// retrieving the current *testing.T context.
+// retrieving the current *testing.T or *testing.B context.
 type Suite struct {
 	*assert.Assertions
-	t *testing.T
+	require *require.Requirements
+	t       *testing.T
+	b       *testing.B
+	tb      testing.TB
+
+	// s is the testing or benchmarking suite embedding this Suite,
+	// which is where Run looks for the SetupSubTest and
+	// TearDownSubTest hooks.
+	s interface{}
+
+	// suiteCleanups and testCleanups are where Run keeps the
+	// functions registered with CleanupSuite and Cleanup.
+	suiteCleanups, testCleanups *teardownStack
+
+	// testName is the name of the current test method, followed by
+	// those of the subtests started with Run, as in
+	// TestExample/subtest.
+	testName string
+}
+
+// suiteSetter is implemented by Suite, so that Run can tell a Suite
+// which testing suite it is embedded in.
+type suiteSetter interface {
+	setS(interface{})
+}
+
+// tbSetter is implemented by Suite, so that Run can bind its
+// assertions and TB() to something other than a *testing.T or
+// *testing.B.
+type tbSetter interface {
+	setTB(testing.TB)
+}
+
+// testNameSetter is implemented by Suite, so that Run can tell it the
+// name of the current test method.
+type testNameSetter interface {
+	setTestName(string)
+}
+
+// cleanupSetter is implemented by Suite, so that Run can give it the
+// stacks Cleanup and CleanupSuite push functions onto.
+type cleanupSetter interface {
+	setSuiteCleanups(*teardownStack)
+	setTestCleanups(*teardownStack)
 }
 
-// T retrieves the current *testing.T context.
+// T retrieves the current *testing.T context.  Benchmarks have none,
+// so called while the suite runs benchmarks, T fails the benchmark
+// with a message pointing at TB rather than returning nil.
 func (suite *Suite) T() *testing.T {
+	if suite.t == nil && suite.b != nil {
+		suite.b.Fatalf("testify: T() has no *testing.T to return while running benchmarks, use TB() instead")
+	}
 	return suite.t
 }
 
 // SetT sets the current *testing.T context.
 func (suite *Suite) SetT(t *testing.T) {
 	suite.t = t
+	suite.b = nil
+	suite.tb = t
 	suite.Assertions = assert.New(t)
+	suite.require = require.New(t)
+}
+
+// Require returns the requirements for the current context, which
+// are like the assertion methods of the suite, except that they stop
+// the current test with FailNow when they fail.  The TearDownTest
+// method and cleanups of the test still run.
+func (suite *Suite) Require() *require.Requirements {
+	return suite.require
+}
+
+// GoldenFile asserts that actual is equal to the content of the golden
+// file named after the suite and the current test, as in
+// testdata/ExampleTestSuite/TestExample.golden.  Unlike the Golden
+// assertion method, it needs no name for the golden file.
+func (suite *Suite) GoldenFile(actual interface{}, msgAndArgs ...interface{}) bool {
+	return assert.Golden(suite.TB(), suite.goldenName(), actual, msgAndArgs...)
+}
+
+// GoldenTextFile is like GoldenFile, but ignores differences in line
+// endings.
+func (suite *Suite) GoldenTextFile(actual interface{}, msgAndArgs ...interface{}) bool {
+	return assert.GoldenText(suite.TB(), suite.goldenName(), actual, msgAndArgs...)
+}
+
+// goldenName returns the name of the suite followed by the name of the
+// current test.
+func (suite *Suite) goldenName() string {
+	if suite.s == nil {
+		return suite.testName
+	}
+	return getSuiteName(reflect.TypeOf(suite.s)) + "/" + suite.testName
+}
+
+// B retrieves the current *testing.B context.
+func (suite *Suite) B() *testing.B {
+	return suite.b
+}
+
+// SetB sets the current *testing.B context.
+func (suite *Suite) SetB(b *testing.B) {
+	suite.t = nil
+	suite.b = b
+	suite.tb = b
+	suite.Assertions = assert.New(b)
+	suite.require = require.New(b)
+}
+
+// TB retrieves the current context, whichever of *testing.T or
+// *testing.B was set last.  Hooks shared by tests and benchmarks
+// should use it rather than T() or B().
+func (suite *Suite) TB() testing.TB {
+	return suite.tb
+}
+
+// setTB binds TB() and the assertions of the suite to tb, leaving T()
+// and B() as they are.
+func (suite *Suite) setTB(tb testing.TB) {
+	suite.tb = tb
+	suite.Assertions = assert.New(tb)
+	suite.require = require.New(tb)
+}
+
+func (suite *Suite) setS(s interface{}) {
+	suite.s = s
+}
+
+func (suite *Suite) setTestName(name string) {
+	suite.testName = name
+}
+
+func (suite *Suite) setSuiteCleanups(cleanups *teardownStack) {
+	suite.suiteCleanups = cleanups
+}
+
+func (suite *Suite) setTestCleanups(cleanups *teardownStack) {
+	suite.testCleanups = cleanups
+}
+
+// Cleanup registers f to be called when the current test is done,
+// after its TearDownTest method.  Functions registered by Cleanup are
+// called last registered first, and a panic in one of them fails the
+// test without stopping the others.  Called outside of a test, as
+// from SetupSuite, Cleanup is the same as CleanupSuite.
+func (suite *Suite) Cleanup(f func()) {
+	switch {
+	case suite.testCleanups != nil:
+		suite.testCleanups.push(f)
+	case suite.suiteCleanups != nil:
+		suite.suiteCleanups.push(f)
+	default:
+		suite.TB().Cleanup(f)
+	}
+}
+
+// CleanupSuite registers f to be called when the whole suite is done,
+// after its TearDownSuite method.  It is meant to be called from
+// SetupSuite, and is otherwise like Cleanup.
+func (suite *Suite) CleanupSuite(f func()) {
+	if suite.suiteCleanups == nil {
+		suite.TB().Cleanup(f)
+		return
+	}
+	suite.suiteCleanups.push(f)
+}
+
+// Run runs subtest as a subtest of the current test, called name.
+// For the duration of the subtest, T() and the assertion methods of
+// the suite refer to the subtest's *testing.T context, and the
+// SetupSubTest and TearDownSubTest hooks of the suite are run around
+// it.  It should be used instead of T().Run inside suite methods.
+// While the suite runs benchmarks, subtest is run as a
+// sub-benchmark instead, with B() referring to it.
+//
+// Run reports whether the subtest succeeded.
+func (suite *Suite) Run(name string, subtest func()) bool {
+	parentName := suite.testName
+	defer suite.setTestName(parentName)
+
+	// An earlier attempt at a retried method must not fail the test,
+	// so its subtests report to recorders of their own.
+	if recorder, ok := suite.tb.(*recordingTB); ok {
+		defer suite.setTB(recorder)
+
+		return recorder.run(name, func(sub *recordingTB) {
+			suite.setTB(sub)
+			suite.setTestName(parentName + "/" + strings.Replace(name, " ", "_", -1))
+			suite.runSubTest(sub, name, subtest)
+		})
+	}
+
+	if suite.t == nil && suite.b != nil {
+		parentB := suite.b
+		defer suite.SetB(parentB)
+
+		return parentB.Run(name, func(b *testing.B) {
+			suite.SetB(b)
+			suite.setTestName(parentName + strings.TrimPrefix(b.Name(), parentB.Name()))
+			suite.runSubTest(b, name, subtest)
+		})
+	}
+
+	parentT := suite.t
+	defer suite.SetT(parentT)
+
+	return parentT.Run(name, func(t *testing.T) {
+		suite.SetT(t)
+		suite.setTestName(parentName + strings.TrimPrefix(t.Name(), parentT.Name()))
+		suite.runSubTest(t, name, subtest)
+	})
+}
+
+// runSubTest calls subtest, called name, on tb, with the SetupSubTest
+// and TearDownSubTest hooks of the suite around it.
+func (suite *Suite) runSubTest(tb testing.TB, name string, subtest func()) {
+	defer func() {
+		if tearDownSubTest, ok := suite.s.(TearDownSubTest); ok {
+			callHook(tb, "TearDownSubTest", tearDownSubTest.TearDownSubTest)
+		}
+	}()
+	if setupSubTest, ok := suite.s.(SetupSubTest); ok {
+		if !callHook(tb, "SetupSubTest", setupSubTest.SetupSubTest) {
+			return
+		}
+	}
+	callHook(tb, name, subtest)
 }
 
 // Run takes a testing suite and runs all of the tests attached
 // to it.
 func Run(t *testing.T, suite TestingSuite) {
+	run(t, suite, false)
+}
+
+// RunParallel takes a testing suite and runs all of the tests
+// attached to it in parallel.  Each test is run against its own
+// shallow copy of the suite, so SetupTest and TearDownTest only ever
+// see the copy belonging to the test they surround.  SetupSuite and
+// TearDownSuite are still run once, on the original suite, before and
+// after all of the tests.  The suite must be a pointer to a struct.
+//
+// As with any parallel subtests, the tests only start once the
+// calling test function has returned, so TearDownSuite is run from
+// t.Cleanup rather than before RunParallel returns.
+func RunParallel(t *testing.T, suite TestingSuite) {
+	run(t, suite, true)
+}
+
+func run(t *testing.T, suite TestingSuite, parallel bool) {
+	if parallel {
+		if _, ok := copySuite(suite); !ok {
+			t.Fatalf("testify: RunParallel requires a pointer to a struct, got %T", suite)
+		}
+	}
+
+	if setter, ok := suite.(suiteSetter); ok {
+		setter.setS(suite)
+	}
 	suite.SetT(t)
 
+	suiteName := getSuiteName(reflect.TypeOf(suite))
+	stats := newSuiteStats()
+
+	// abandoned is set when a test method timed out and was left
+	// running on the suite.
+	abandoned := false
+
+	// Tear the suite down even if SetupSuite panics or stops the
+	// test with FailNow or Skip.
+	var teardowns teardownStack
+	tearDownSuite := func() {
+		if tearDownAllSuite, ok := suite.(TearDownAllSuite); ok {
+			callHook(t, "TearDownSuite", tearDownAllSuite.TearDownSuite)
+		}
+		teardowns.run(t, "cleanup")
+		stats.finish()
+		if flaky := stats.flakySummary(); flaky != "" {
+			// Printed rather than logged, so that it shows
+			// without -v when the suite passes.
+			fmt.Printf("testify: tests of %s that needed more than one attempt: %s\n", suiteName, flaky)
+		}
+		if suiteWithStats, ok := suite.(WithStats); ok {
+			callHook(t, "HandleStats", func() {
+				suiteWithStats.HandleStats(suiteName, stats)
+			})
+		}
+		if abandoned {
+			abandon(suite)
+		}
+	}
+	if parallel {
+		t.Cleanup(tearDownSuite)
+	} else {
+		defer tearDownSuite()
+	}
+
+	if setter, ok := suite.(cleanupSetter); ok {
+		setter.setSuiteCleanups(&teardowns)
+	}
+	if !injectFixtures(t, suite, SuiteScope, &teardowns) {
+		return
+	}
 	if setupAllSuite, ok := suite.(SetupAllSuite); ok {
-		setupAllSuite.SetupSuite()
+		if !callHook(t, "SetupSuite", setupAllSuite.SetupSuite) {
+			return
+		}
+	}
+
+	tests, focused := suiteTests(suite)
+	if focused && inCI() {
+		t.Errorf("testify: %s has focused methods, which are not allowed in CI", suiteName)
+	}
+
+	for _, test := range tests {
+		test := test
+		if focused && !test.focused {
+			continue
+		}
+		if test.disabled {
+			t.Run(test.name, func(t *testing.T) {
+				t.Skipf("testify: %s is disabled", test.method.Name)
+			})
+			continue
+		}
+		t.Run(test.name, func(t *testing.T) {
+			suite := suite
+			if parallel {
+				suite, _ = copySuite(suite)
+				if setter, ok := suite.(suiteSetter); ok {
+					setter.setS(suite)
+				}
+				t.Parallel()
+			}
+
+			stats.start(test.name)
+			attempts := maxAttempts(suite, test)
+			attempt := 1
+			completed := true
+			defer func() {
+				stats.end(test.name, !t.Failed(), attempt)
+				if !completed && parallel {
+					// Only this copy of the suite is left to
+					// the method.
+					abandon(suite)
+				} else if !completed {
+					abandoned = true
+				}
+			}()
+
+			// Only the failure of the last attempt is reported as
+			// the failure of the test, and only the last attempt
+			// runs on t itself.
+			for ; attempt < attempts; attempt++ {
+				recorder, ok := runAttempt(t, suite, suiteName, test)
+				completed = ok && completed
+				switch {
+				case recorder.Skipped():
+					t.Logf("testify: attempt %d of %d of %s skipped:\n%s", attempt, attempts, test.name, recorder.output())
+					t.SkipNow()
+				case !recorder.Failed():
+					t.Logf("testify: %s passed on attempt %d of %d", test.name, attempt, attempts)
+					if output := recorder.output(); output != "" {
+						t.Log(output)
+					}
+					return
+				}
+				t.Logf("testify: attempt %d of %d of %s failed:\n%s", attempt, attempts, test.name, recorder.output())
+			}
+			completed = runTest(t, t, suite, suiteName, test) && completed
+		})
+	}
+}
+
+// runTest runs the test method of suite, and the hooks around it, on
+// t, reporting to tb, which is t itself or a recordingTB standing in
+// for it.  It returns false if the method timed out and was left
+// running.
+func runTest(t *testing.T, tb testing.TB, suite TestingSuite, suiteName string, test suiteTest) bool {
+	parentT := suite.T()
+	suite.SetT(t)
+	if setter, ok := suite.(tbSetter); ok && tb != testing.TB(t) {
+		setter.setTB(tb)
+	}
+	if setter, ok := suite.(testNameSetter); ok {
+		setter.setTestName(test.name)
+		defer setter.setTestName("")
+	}
+	var teardowns teardownStack
+	if setter, ok := suite.(cleanupSetter); ok {
+		setter.setTestCleanups(&teardowns)
+		defer setter.setTestCleanups(nil)
 	}
 	defer func() {
-		if tearDownAllSuite, ok := suite.(TearDownAllSuite); ok {
-			tearDownAllSuite.TearDownSuite()
+		if afterTestSuite, ok := suite.(AfterTest); ok {
+			callHook(tb, "AfterTest", func() {
+				afterTestSuite.AfterTest(suiteName, test.name)
+			})
+		}
+		if tearDownTestSuite, ok := suite.(TearDownTestSuite); ok {
+			callHook(tb, "TearDownTest", tearDownTestSuite.TearDownTest)
+		}
+		teardowns.run(tb, "cleanup")
+		suite.SetT(parentT)
+	}()
+	if !injectFixtures(tb, suite, TestScope, &teardowns) {
+		return true
+	}
+	if setupTestSuite, ok := suite.(SetupTestSuite); ok {
+		if !callHook(tb, "SetupTest", setupTestSuite.SetupTest) {
+			return true
+		}
+	}
+	if beforeTestSuite, ok := suite.(BeforeTest); ok {
+		if !callHook(tb, "BeforeTest", func() {
+			beforeTestSuite.BeforeTest(suiteName, test.name)
+		}) {
+			return true
+		}
+	}
+	return callWithTimeout(tb, test.method.Name, methodTimeout(suite, test.name), func() {
+		test.method.Func.Call([]reflect.Value{reflect.ValueOf(suite)})
+	})
+}
+
+// callHook calls f, which is a test method or hook of a suite called
+// name.  If f panics, the panic is reported as a failure of tb along
+// with the stack of the panic, and callHook returns false rather than
+// letting the panic abort the whole test binary.
+func callHook(tb testing.TB, name string, f func()) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			tb.Errorf("testify: %s panicked: %v\n%s", name, r, debug.Stack())
 		}
 	}()
+	f()
+	return true
+}
+
+// getSuiteName returns the name of the type of a suite, looking through
+// the pointer most suites are passed as.
+func getSuiteName(suiteType reflect.Type) string {
+	if suiteType.Kind() == reflect.Ptr {
+		suiteType = suiteType.Elem()
+	}
+	return suiteType.Name()
+}
+
+// copySuite returns a shallow copy of the struct the suite points
+// to, so that a test run in parallel can own its suite state.
+func copySuite(suite TestingSuite) (TestingSuite, bool) {
+	value := reflect.ValueOf(suite)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return nil, false
+	}
+	copied := reflect.New(value.Elem().Type())
+	copied.Elem().Set(value.Elem())
+	return copied.Interface().(TestingSuite), true
+}
+
+// suiteTest is a method of a suite selected to be run as a test.
+type suiteTest struct {
+	method reflect.Method
+	// name is the name of the test, which is the name of the method
+	// without its "F" or "X" prefix.
+	name string
+	// focused is set for methods beginning with "FTest", which are
+	// the only ones run if a suite has any.
+	focused bool
+	// disabled is set for methods beginning with "XTest", which are
+	// reported as skipped.
+	disabled bool
+	// tags are the tags of the method, from TaggedSuite.
+	tags []string
+}
+
+// suiteTests returns the methods of suite to run as tests, and whether
+// any of them are focused.
+func suiteTests(suite TestingSuite) (tests []suiteTest, focused bool) {
+	var tags map[string][]string
+	if taggedSuite, ok := suite.(TaggedSuite); ok {
+		tags = taggedSuite.Tags()
+	}
 
 	methodFinder := reflect.TypeOf(suite)
-	tests := []testing.InternalTest{}
 	for index := 0; index < methodFinder.NumMethod(); index++ {
-		method := methodFinder.Method(index)
-		ok, err := methodFilter(method.Name)
+		test := suiteTest{method: methodFinder.Method(index)}
+		test.name = test.method.Name
+		switch {
+		case strings.HasPrefix(test.name, "FTest"):
+			test.name = test.name[1:]
+			test.focused = true
+		case strings.HasPrefix(test.name, "XTest"):
+			test.name = test.name[1:]
+			test.disabled = true
+		}
+
+		ok, err := methodFilter(test.name)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "testify: invalid regexp for -m: %s\n", err)
 			os.Exit(1)
 		}
-		if ok {
-			test := testing.InternalTest{
-				Name: method.Name,
-				F: func(t *testing.T) {
-					parentT := suite.T()
-					suite.SetT(t)
-					if setupTestSuite, ok := suite.(SetupTestSuite); ok {
-						setupTestSuite.SetupTest()
-					}
-					defer func() {
-						if tearDownTestSuite, ok := suite.(TearDownTestSuite); ok {
-							tearDownTestSuite.TearDownTest()
-						}
-						suite.SetT(parentT)
-					}()
-					method.Func.Call([]reflect.Value{reflect.ValueOf(suite)})
-				},
-			}
-			tests = append(tests, test)
+		test.tags = tags[test.name]
+		if !ok || !tagFilter(test.tags) {
+			continue
 		}
-	}
 
-	if !testing.RunTests(func(_, _ string) (bool, error) { return true, nil },
-		tests) {
-		t.Fail()
+		tests = append(tests, test)
+		focused = focused || test.focused
 	}
+	return tests, focused
 }
 
 // Filtering method according to set regular expression
-// specified command-line argument -m
+// specified command-line argument -m.  Since every method is run as a
+// subtest, "-run TestSuite/TestMethod" selects methods as well; -m is
+// kept for compatibility.
 func methodFilter(name string) (bool, error) {
 	if ok, _ := regexp.MatchString("^Test", name); !ok {
 		return false, nil
 	}
 	return regexp.MatchString(*matchMethod, name)
 }
+
+// tagFilter reports whether a method with the given tags is selected
+// by the command-line arguments -testify.tags and
+// -testify.exclude-tags.
+func tagFilter(tags []string) bool {
+	for _, tag := range tags {
+		if listContains(*excludeTags, tag) {
+			return false
+		}
+	}
+	if *includeTags == "" {
+		return true
+	}
+	for _, tag := range tags {
+		if listContains(*includeTags, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// listContains reports whether the comma-separated list contains
+// value.
+func listContains(list, value string) bool {
+	for _, item := range strings.Split(list, ",") {
+		if strings.TrimSpace(item) == value {
+			return true
+		}
+	}
+	return false
+}
+
+// inCI reports whether the tests are being run by a continuous
+// integration service, going by the CI environment variable most of
+// them set.
+func inCI() bool {
+	ci := os.Getenv("CI")
+	return ci != "" && ci != "false" && ci != "0"
+}
@@ -2,9 +2,12 @@ package suite
 
 import (
 	"errors"
+	"flag"
 	"io/ioutil"
 	"os"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -176,13 +179,17 @@ func (sc *StdoutCapture) StopCapture() (string, error) {
 }
 
 func TestSuiteLogging(t *testing.T) {
-	testT := testing.T{}
-
 	suiteLoggingTester := new(SuiteLoggingTester)
 
 	capture := StdoutCapture{}
+	internalTest := testing.InternalTest{
+		Name: "SomeTest",
+		F: func(subT *testing.T) {
+			Run(subT, suiteLoggingTester)
+		},
+	}
 	capture.StartCapture()
-	Run(&testT, suiteLoggingTester)
+	testing.RunTests(allTestsFilter, []testing.InternalTest{internalTest})
 	output, err := capture.StopCapture()
 
 	assert.Nil(t, err, "Got an error trying to capture stdout!")
@@ -197,3 +204,599 @@ func TestSuiteLogging(t *testing.T) {
 		assert.NotContains(t, output, "TESTLOGPASS")
 	}
 }
+
+// parallelCounts is shared by every copy of SuiteParallelTester, so
+// it can record what happened across all of the parallel tests.
+type parallelCounts struct {
+	sync.Mutex
+	SetupTestRunCount    int
+	TearDownTestRunCount int
+	TestRunCount         int
+}
+
+type SuiteParallelTester struct {
+	Suite
+
+	Counts *parallelCounts
+
+	SetupSuiteRunCount    int
+	TearDownSuiteRunCount int
+
+	// Only ever incremented on the copy of the suite owned by a
+	// single test, so each test should see it set to exactly one.
+	OwnSetupTestRunCount int
+}
+
+func (suite *SuiteParallelTester) SetupSuite() {
+	suite.SetupSuiteRunCount++
+}
+
+func (suite *SuiteParallelTester) TearDownSuite() {
+	suite.TearDownSuiteRunCount++
+}
+
+func (suite *SuiteParallelTester) SetupTest() {
+	suite.OwnSetupTestRunCount++
+	suite.Counts.Lock()
+	suite.Counts.SetupTestRunCount++
+	suite.Counts.Unlock()
+}
+
+func (suite *SuiteParallelTester) TearDownTest() {
+	suite.Counts.Lock()
+	suite.Counts.TearDownTestRunCount++
+	suite.Counts.Unlock()
+}
+
+func (suite *SuiteParallelTester) TestOne() {
+	suite.Equal(1, suite.OwnSetupTestRunCount)
+	suite.Counts.Lock()
+	suite.Counts.TestRunCount++
+	suite.Counts.Unlock()
+}
+
+func (suite *SuiteParallelTester) TestTwo() {
+	suite.Equal(1, suite.OwnSetupTestRunCount)
+	suite.Counts.Lock()
+	suite.Counts.TestRunCount++
+	suite.Counts.Unlock()
+}
+
+func TestRunParallelSuite(t *testing.T) {
+	suiteTester := &SuiteParallelTester{Counts: new(parallelCounts)}
+	// The parallel tests only run once the function that started
+	// them returns, so run the suite in a subtest to wait for it.
+	t.Run("Suite", func(t *testing.T) {
+		RunParallel(t, suiteTester)
+	})
+
+	assert.Equal(t, 1, suiteTester.SetupSuiteRunCount)
+	assert.Equal(t, 1, suiteTester.TearDownSuiteRunCount)
+
+	// SetupTest and TearDownTest ran once for each test, but only
+	// on the copies of the suite.
+	assert.Equal(t, 2, suiteTester.Counts.SetupTestRunCount)
+	assert.Equal(t, 2, suiteTester.Counts.TearDownTestRunCount)
+	assert.Equal(t, 2, suiteTester.Counts.TestRunCount)
+	assert.Equal(t, 0, suiteTester.OwnSetupTestRunCount)
+}
+
+type SuiteNameTester struct {
+	Suite
+
+	TestNames []string
+}
+
+func (suite *SuiteNameTester) TestOne() {
+	suite.TestNames = append(suite.TestNames, suite.T().Name())
+}
+
+func (suite *SuiteNameTester) TestTwo() {
+	suite.TestNames = append(suite.TestNames, suite.T().Name())
+}
+
+// Each method is run as a subtest of the calling test, which is what
+// lets "-run TestSuiteSubtests/TestOne" pick out a single method.
+func TestSuiteSubtests(t *testing.T) {
+	suiteTester := new(SuiteNameTester)
+	Run(t, suiteTester)
+
+	assert.Equal(t, []string{"TestSuiteSubtests/TestOne", "TestSuiteSubtests/TestTwo"}, suiteTester.TestNames)
+}
+
+type SuiteSubTestTester struct {
+	Suite
+
+	SetupSubTestRunCount    int
+	TearDownSubTestRunCount int
+	SubTestNames            []string
+}
+
+func (suite *SuiteSubTestTester) SetupSubTest() {
+	suite.SetupSubTestRunCount++
+}
+
+func (suite *SuiteSubTestTester) TearDownSubTest() {
+	suite.TearDownSubTestRunCount++
+}
+
+func (suite *SuiteSubTestTester) TestSubTests() {
+	parentT := suite.T()
+
+	ok := suite.Run("First", func() {
+		suite.NotEqual(parentT, suite.T())
+		suite.SubTestNames = append(suite.SubTestNames, suite.T().Name())
+
+		suite.Run("Nested", func() {
+			suite.SubTestNames = append(suite.SubTestNames, suite.T().Name())
+		})
+	})
+	suite.True(ok)
+	suite.Equal(parentT, suite.T())
+
+	ok = suite.Run("Second", func() {
+		suite.SubTestNames = append(suite.SubTestNames, suite.T().Name())
+	})
+	suite.True(ok)
+}
+
+func TestSuiteRunSubTests(t *testing.T) {
+	suiteTester := new(SuiteSubTestTester)
+	Run(t, suiteTester)
+
+	assert.Equal(t, []string{
+		"TestSuiteRunSubTests/TestSubTests/First",
+		"TestSuiteRunSubTests/TestSubTests/First/Nested",
+		"TestSuiteRunSubTests/TestSubTests/Second",
+	}, suiteTester.SubTestNames)
+	assert.Equal(t, 3, suiteTester.SetupSubTestRunCount)
+	assert.Equal(t, 3, suiteTester.TearDownSubTestRunCount)
+}
+
+type SuiteBeforeAfterTester struct {
+	Suite
+
+	// Records the hooks in the order they were called.
+	Calls []string
+}
+
+func (suite *SuiteBeforeAfterTester) SetupTest() {
+	suite.Calls = append(suite.Calls, "SetupTest")
+}
+
+func (suite *SuiteBeforeAfterTester) BeforeTest(suiteName, testName string) {
+	suite.Calls = append(suite.Calls, "BeforeTest "+suiteName+"."+testName)
+}
+
+func (suite *SuiteBeforeAfterTester) AfterTest(suiteName, testName string) {
+	suite.Calls = append(suite.Calls, "AfterTest "+suiteName+"."+testName)
+}
+
+func (suite *SuiteBeforeAfterTester) TearDownTest() {
+	suite.Calls = append(suite.Calls, "TearDownTest")
+}
+
+func (suite *SuiteBeforeAfterTester) TestOne() {
+	suite.Calls = append(suite.Calls, "TestOne")
+}
+
+func TestSuiteBeforeAfterTest(t *testing.T) {
+	suiteTester := new(SuiteBeforeAfterTester)
+	Run(t, suiteTester)
+
+	assert.Equal(t, []string{
+		"SetupTest",
+		"BeforeTest SuiteBeforeAfterTester.TestOne",
+		"TestOne",
+		"AfterTest SuiteBeforeAfterTester.TestOne",
+		"TearDownTest",
+	}, suiteTester.Calls)
+}
+
+type SuiteStatsTester struct {
+	Suite
+
+	SuiteName string
+	Stats     *SuiteStats
+
+	TearDownSuiteRunCount int
+	// Set by HandleStats, to check it runs after TearDownSuite.
+	TearDownSuiteRunCountInHandleStats int
+}
+
+func (suite *SuiteStatsTester) TearDownSuite() {
+	suite.TearDownSuiteRunCount++
+}
+
+func (suite *SuiteStatsTester) HandleStats(suiteName string, stats *SuiteStats) {
+	suite.SuiteName = suiteName
+	suite.Stats = stats
+	suite.TearDownSuiteRunCountInHandleStats = suite.TearDownSuiteRunCount
+}
+
+func (suite *SuiteStatsTester) TestPass() {
+	time.Sleep(time.Millisecond)
+}
+
+func (suite *SuiteStatsTester) TestFail() {
+	suite.T().Fail()
+}
+
+func TestSuiteStats(t *testing.T) {
+	suiteTester := new(SuiteStatsTester)
+	ok, _ := runCaptured(t, suiteTester)
+	assert.False(t, ok)
+
+	assert.Equal(t, "SuiteStatsTester", suiteTester.SuiteName)
+	assert.Equal(t, 1, suiteTester.TearDownSuiteRunCountInHandleStats)
+
+	stats := suiteTester.Stats
+	if assert.NotNil(t, stats) {
+		assert.False(t, stats.Passed())
+		assert.False(t, stats.End.Before(stats.Start))
+		assert.Equal(t, stats.End.Sub(stats.Start), stats.Duration)
+		assert.Len(t, stats.TestStats, 2)
+
+		pass := stats.TestStats["TestPass"]
+		if assert.NotNil(t, pass) {
+			assert.Equal(t, "TestPass", pass.TestName)
+			assert.True(t, pass.Passed)
+			assert.True(t, pass.Duration >= time.Millisecond)
+			assert.Equal(t, pass.End.Sub(pass.Start), pass.Duration)
+		}
+
+		fail := stats.TestStats["TestFail"]
+		if assert.NotNil(t, fail) {
+			assert.False(t, fail.Passed)
+		}
+	}
+}
+
+type SuitePanicTester struct {
+	Suite
+
+	PanicInSetupSuite bool
+	PanicInSetupTest  bool
+
+	TearDownSuiteRunCount int
+	TearDownTestRunCount  int
+	TestRunCount          int
+}
+
+func (suite *SuitePanicTester) SetupSuite() {
+	if suite.PanicInSetupSuite {
+		panic("SetupSuite went wrong")
+	}
+}
+
+func (suite *SuitePanicTester) TearDownSuite() {
+	suite.TearDownSuiteRunCount++
+}
+
+func (suite *SuitePanicTester) SetupTest() {
+	if suite.PanicInSetupTest {
+		panic("SetupTest went wrong")
+	}
+}
+
+func (suite *SuitePanicTester) TearDownTest() {
+	suite.TearDownTestRunCount++
+}
+
+func (suite *SuitePanicTester) TestOnePanics() {
+	suite.TestRunCount++
+	panic("TestOnePanics went wrong")
+}
+
+func (suite *SuitePanicTester) TestTwoRuns() {
+	suite.TestRunCount++
+}
+
+// allTestsFilter lets testing.RunTests run every test it is given.
+func allTestsFilter(_, _ string) (bool, error) {
+	return true, nil
+}
+
+// runCaptured runs the suite in a test of its own, so that its failures
+// don't fail the calling test, and returns whether it passed along
+// with everything it printed.
+func runCaptured(t *testing.T, suite TestingSuite) (bool, string) {
+	capture := StdoutCapture{}
+	internalTest := testing.InternalTest{
+		Name: "TestSuite",
+		F: func(t *testing.T) {
+			Run(t, suite)
+		},
+	}
+	capture.StartCapture()
+	ok := testing.RunTests(allTestsFilter, []testing.InternalTest{internalTest})
+	output, err := capture.StopCapture()
+	assert.Nil(t, err, "Got an error trying to capture stdout!")
+	return ok, output
+}
+
+func TestSuitePanicInTest(t *testing.T) {
+	suiteTester := new(SuitePanicTester)
+	ok, output := runCaptured(t, suiteTester)
+
+	assert.False(t, ok)
+	assert.Contains(t, output, "--- FAIL: TestSuite/TestOnePanics")
+	assert.NotContains(t, output, "--- FAIL: TestSuite/TestTwoRuns")
+	assert.Contains(t, output, "testify: TestOnePanics panicked: TestOnePanics went wrong")
+	// The stack of the panic is part of the failure.
+	assert.Contains(t, output, "(*SuitePanicTester).TestOnePanics")
+
+	// The panic did not stop the other test, nor the teardown.
+	assert.Equal(t, 2, suiteTester.TestRunCount)
+	assert.Equal(t, 2, suiteTester.TearDownTestRunCount)
+	assert.Equal(t, 1, suiteTester.TearDownSuiteRunCount)
+}
+
+func TestSuitePanicInSetupTest(t *testing.T) {
+	suiteTester := &SuitePanicTester{PanicInSetupTest: true}
+	ok, output := runCaptured(t, suiteTester)
+
+	assert.False(t, ok)
+	assert.Contains(t, output, "--- FAIL: TestSuite/TestOnePanics")
+	assert.Contains(t, output, "--- FAIL: TestSuite/TestTwoRuns")
+	assert.Contains(t, output, "testify: SetupTest panicked: SetupTest went wrong")
+
+	// The tests are not run after a failed setup, but are torn down.
+	assert.Equal(t, 0, suiteTester.TestRunCount)
+	assert.Equal(t, 2, suiteTester.TearDownTestRunCount)
+	assert.Equal(t, 1, suiteTester.TearDownSuiteRunCount)
+}
+
+func TestSuitePanicInSetupSuite(t *testing.T) {
+	suiteTester := &SuitePanicTester{PanicInSetupSuite: true}
+	ok, output := runCaptured(t, suiteTester)
+
+	assert.False(t, ok)
+	assert.Contains(t, output, "testify: SetupSuite panicked: SetupSuite went wrong")
+
+	assert.Equal(t, 0, suiteTester.TestRunCount)
+	assert.Equal(t, 0, suiteTester.TearDownTestRunCount)
+	assert.Equal(t, 1, suiteTester.TearDownSuiteRunCount)
+}
+
+type SuiteFilterTester struct {
+	Suite
+
+	TestNames []string
+}
+
+func (suite *SuiteFilterTester) Tags() map[string][]string {
+	return map[string][]string{
+		"TestFast":  {"fast"},
+		"TestSlow":  {"slow", "db"},
+		"TestOther": {"fast", "db"},
+	}
+}
+
+func (suite *SuiteFilterTester) TestFast() {
+	suite.TestNames = append(suite.TestNames, "TestFast")
+}
+
+func (suite *SuiteFilterTester) TestSlow() {
+	suite.TestNames = append(suite.TestNames, "TestSlow")
+}
+
+func (suite *SuiteFilterTester) TestOther() {
+	suite.TestNames = append(suite.TestNames, "TestOther")
+}
+
+func (suite *SuiteFilterTester) XTestDisabled() {
+	suite.TestNames = append(suite.TestNames, "TestDisabled")
+}
+
+// setFlag sets a command-line flag for the rest of the test.
+func setFlag(t *testing.T, name, value string) {
+	old := flag.Lookup(name).Value.String()
+	flag.Set(name, value)
+	t.Cleanup(func() {
+		flag.Set(name, old)
+	})
+}
+
+func TestSuiteTags(t *testing.T) {
+	suiteTester := new(SuiteFilterTester)
+	Run(t, suiteTester)
+	assert.Equal(t, []string{"TestFast", "TestOther", "TestSlow"}, suiteTester.TestNames)
+
+	setFlag(t, "testify.tags", "fast")
+	suiteTester = new(SuiteFilterTester)
+	Run(t, suiteTester)
+	assert.Equal(t, []string{"TestFast", "TestOther"}, suiteTester.TestNames)
+
+	setFlag(t, "testify.exclude-tags", "db")
+	suiteTester = new(SuiteFilterTester)
+	Run(t, suiteTester)
+	assert.Equal(t, []string{"TestFast"}, suiteTester.TestNames)
+}
+
+func TestSuiteDisabledMethods(t *testing.T) {
+	suiteTester := new(SuiteFilterTester)
+	ok, output := runCaptured(t, suiteTester)
+
+	assert.True(t, ok)
+	assert.NotContains(t, suiteTester.TestNames, "TestDisabled")
+	if testing.Verbose() {
+		assert.Contains(t, output, "--- SKIP: TestSuite/TestDisabled")
+	}
+}
+
+type SuiteFocusTester struct {
+	Suite
+
+	TestNames []string
+}
+
+func (suite *SuiteFocusTester) FTestFocused() {
+	suite.TestNames = append(suite.TestNames, suite.T().Name())
+}
+
+func (suite *SuiteFocusTester) TestNotFocused() {
+	suite.TestNames = append(suite.TestNames, suite.T().Name())
+}
+
+func TestSuiteFocus(t *testing.T) {
+	t.Setenv("CI", "")
+	suiteTester := new(SuiteFocusTester)
+	ok, _ := runCaptured(t, suiteTester)
+
+	assert.True(t, ok)
+	assert.Equal(t, []string{"TestSuite/TestFocused"}, suiteTester.TestNames)
+}
+
+func TestSuiteFocusInCI(t *testing.T) {
+	t.Setenv("CI", "true")
+	suiteTester := new(SuiteFocusTester)
+	ok, output := runCaptured(t, suiteTester)
+
+	assert.False(t, ok)
+	assert.Contains(t, output, "testify: SuiteFocusTester has focused methods, which are not allowed in CI")
+	assert.Equal(t, []string{"TestSuite/TestFocused"}, suiteTester.TestNames)
+}
+
+type SuiteCleanupTester struct {
+	Suite
+
+	// Records hooks and cleanups in the order they were called.
+	Calls []string
+}
+
+func (suite *SuiteCleanupTester) record(call string) func() {
+	return func() {
+		suite.Calls = append(suite.Calls, call)
+	}
+}
+
+func (suite *SuiteCleanupTester) SetupSuite() {
+	suite.CleanupSuite(suite.record("suite cleanup 1"))
+	suite.Cleanup(suite.record("suite cleanup 2"))
+}
+
+func (suite *SuiteCleanupTester) TearDownSuite() {
+	suite.record("TearDownSuite")()
+}
+
+func (suite *SuiteCleanupTester) TearDownTest() {
+	suite.record("TearDownTest")()
+}
+
+func (suite *SuiteCleanupTester) TestCleanups() {
+	suite.Cleanup(suite.record("test cleanup 1"))
+	suite.Cleanup(func() {
+		panic("CLEANUP PANIC")
+	})
+	suite.Cleanup(suite.record("test cleanup 3"))
+}
+
+func TestSuiteCleanup(t *testing.T) {
+	suiteTester := new(SuiteCleanupTester)
+	ok, output := runCaptured(t, suiteTester)
+
+	// The panicking cleanup fails the test, but doesn't keep the
+	// other cleanups from running.
+	assert.False(t, ok)
+	assert.Contains(t, output, "--- FAIL: TestSuite/TestCleanups")
+	assert.Contains(t, output, "testify: cleanup panicked: CLEANUP PANIC")
+
+	assert.Equal(t, []string{
+		"TearDownTest",
+		"test cleanup 3",
+		"test cleanup 1",
+		"TearDownSuite",
+		"suite cleanup 2",
+		"suite cleanup 1",
+	}, suiteTester.Calls)
+}
+
+type SuiteRequireTester struct {
+	Suite
+
+	TearDownTestRunCount int
+	// Counts the methods that got past their requirements.
+	PassedRequireCount int
+}
+
+func (suite *SuiteRequireTester) TearDownTest() {
+	suite.TearDownTestRunCount++
+}
+
+func (suite *SuiteRequireTester) TestRequireFails() {
+	suite.Require().Equal(1, 2, "REQUIRE FAILED")
+	suite.PassedRequireCount++
+}
+
+func (suite *SuiteRequireTester) TestRequirePasses() {
+	suite.Require().Equal(1, 1)
+	suite.PassedRequireCount++
+}
+
+func TestSuiteRequire(t *testing.T) {
+	suiteTester := new(SuiteRequireTester)
+	ok, output := runCaptured(t, suiteTester)
+
+	assert.False(t, ok)
+	assert.Contains(t, output, "--- FAIL: TestSuite/TestRequireFails")
+	assert.Contains(t, output, "REQUIRE FAILED")
+
+	// The failed requirement stopped its method only, and both
+	// methods were torn down.
+	assert.Equal(t, 1, suiteTester.PassedRequireCount)
+	assert.Equal(t, 2, suiteTester.TearDownTestRunCount)
+}
+
+type SuiteGoldenTester struct {
+	Suite
+
+	TestGoldenRetriedRunCount int
+}
+
+func (suite *SuiteGoldenTester) MaxAttempts(testName string) int {
+	if testName == "TestGoldenRetried" {
+		return 2
+	}
+	return 0
+}
+
+func (suite *SuiteGoldenTester) TestGolden() {
+	suite.GoldenFile("golden\n")
+}
+
+func (suite *SuiteGoldenTester) TestGoldenSubTest() {
+	suite.Run("sub", func() {
+		suite.GoldenTextFile("golden\n")
+	})
+}
+
+// TestGoldenRetried passes on its second attempt, using the same
+// golden file in both.
+func (suite *SuiteGoldenTester) TestGoldenRetried() {
+	suite.TestGoldenRetriedRunCount++
+	suite.GoldenFile("golden\n")
+	suite.Equal(2, suite.TestGoldenRetriedRunCount)
+}
+
+func (suite *SuiteGoldenTester) TestGoldenFails() {
+	suite.GoldenFile("silver\n")
+}
+
+func TestSuiteGolden(t *testing.T) {
+	suiteTester := new(SuiteGoldenTester)
+	ok, output := runCaptured(t, suiteTester)
+
+	// Golden files are named after the suite and its test methods.
+	assert.False(t, ok)
+	assert.NotContains(t, output, "--- FAIL: TestSuite/TestGolden ")
+	assert.NotContains(t, output, "--- FAIL: TestSuite/TestGoldenSubTest")
+	assert.Equal(t, 2, suiteTester.TestGoldenRetriedRunCount)
+	assert.NotContains(t, output, "--- FAIL: TestSuite/TestGoldenRetried")
+	assert.NotContains(t, output, "TestGoldenRetried.golden does not exist")
+	assert.Contains(t, output, "--- FAIL: TestSuite/TestGoldenFails")
+	assert.Contains(t, output, "testdata/SuiteGoldenTester/TestGoldenFails.golden does not exist")
+
+	// The failure is reported where the suite called GoldenFile.
+	assert.Contains(t, output, "Location:\tsuite_test.go:")
+}
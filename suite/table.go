@@ -0,0 +1,97 @@
+package suite
+
+import (
+	"reflect"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var assertionsType = reflect.TypeOf((*assert.Assertions)(nil))
+
+// RunTable runs a table-driven test: test is called once for each of
+// cases, in a subtest started with Run and named after the case.
+//
+// cases must be a slice of structs with a string field called Name.
+// Cases with a bool field called Skip set are skipped, and if any
+// case has a bool field called Focus set, only the focused cases are
+// run.  test must be a func taking the assertions to use for the case
+// and the case itself:
+//
+//	suite.RunTable([]struct {
+//	    Name     string
+//	    In, Want int
+//	}{
+//	    {Name: "zero", In: 0, Want: 0},
+//	    {Name: "one", In: 1, Want: 1},
+//	}, func(assert *assert.Assertions, c struct {
+//	    Name     string
+//	    In, Want int
+//	}) {
+//	    assert.Equal(c.Want, Square(c.In))
+//	})
+//
+// Failures reported through those assertions name the failing case.
+// RunTable reports whether all of the cases succeeded.
+func (suite *Suite) RunTable(cases interface{}, test interface{}) bool {
+	casesValue := reflect.ValueOf(cases)
+	if casesValue.Kind() != reflect.Slice && casesValue.Kind() != reflect.Array {
+		suite.TB().Fatalf("testify: RunTable needs a slice of cases, got %T", cases)
+	}
+	caseType := casesValue.Type().Elem()
+	if caseType.Kind() != reflect.Struct {
+		suite.TB().Fatalf("testify: RunTable needs cases to be structs, got %v", caseType)
+	}
+	if field, ok := caseType.FieldByName("Name"); !ok || field.Type.Kind() != reflect.String {
+		suite.TB().Fatalf("testify: RunTable needs cases to have a string Name field, %v has none", caseType)
+	}
+	testValue := reflect.ValueOf(test)
+	testType := testValue.Type()
+	if testType.Kind() != reflect.Func || testType.NumIn() != 2 || testType.NumOut() != 0 ||
+		testType.In(0) != assertionsType || !caseType.AssignableTo(testType.In(1)) {
+		suite.TB().Fatalf("testify: RunTable needs test to be a func(*assert.Assertions, %v), got %T", caseType, test)
+	}
+
+	focused := false
+	for i := 0; i < casesValue.Len(); i++ {
+		focused = focused || boolField(casesValue.Index(i), "Focus")
+	}
+	if focused && inCI() {
+		suite.TB().Errorf("testify: %s has focused cases, which are not allowed in CI", suite.TB().Name())
+	}
+
+	passed := true
+	for i := 0; i < casesValue.Len(); i++ {
+		c := casesValue.Index(i)
+		name := c.FieldByName("Name").String()
+		skip := boolField(c, "Skip")
+		if focused && !boolField(c, "Focus") {
+			continue
+		}
+		passed = suite.Run(name, func() {
+			if skip {
+				suite.TB().Skipf("testify: case %q is skipped", name)
+			}
+			assertions := assert.New(caseT{TestingT: suite.TB(), name: name})
+			testValue.Call([]reflect.Value{reflect.ValueOf(assertions), c})
+		}) && passed
+	}
+	return passed
+}
+
+// boolField returns the value of the bool field called name of the
+// struct v, or false if it has no such field.
+func boolField(v reflect.Value, name string) bool {
+	field := v.FieldByName(name)
+	return field.IsValid() && field.Kind() == reflect.Bool && field.Bool()
+}
+
+// caseT adds the name of a table case to the failures reported for
+// it.
+type caseT struct {
+	assert.TestingT
+	name string
+}
+
+func (t caseT) Errorf(format string, args ...interface{}) {
+	t.TestingT.Errorf(format+"\r\tCase:\t\t%s\n\r", append(args, t.name)...)
+}
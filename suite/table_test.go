@@ -0,0 +1,86 @@
+package suite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type squareCase struct {
+	Name     string
+	In, Want int
+	Skip     bool
+	Focus    bool
+}
+
+type SuiteTableTester struct {
+	Suite
+
+	Cases []squareCase
+
+	Passed   bool
+	RunCases []string
+}
+
+func (suite *SuiteTableTester) TestSquares() {
+	suite.Passed = suite.RunTable(suite.Cases, func(assert *assert.Assertions, c squareCase) {
+		suite.RunCases = append(suite.RunCases, suite.T().Name())
+		assert.Equal(c.Want, c.In*c.In)
+	})
+}
+
+func TestRunTable(t *testing.T) {
+	suiteTester := &SuiteTableTester{Cases: []squareCase{
+		{Name: "zero", In: 0, Want: 0},
+		{Name: "two", In: 2, Want: 4},
+		{Name: "skipped", In: 2, Want: 5, Skip: true},
+	}}
+	Run(t, suiteTester)
+
+	assert.True(t, suiteTester.Passed)
+	assert.Equal(t, []string{
+		"TestRunTable/TestSquares/zero",
+		"TestRunTable/TestSquares/two",
+	}, suiteTester.RunCases)
+}
+
+func TestRunTableFailure(t *testing.T) {
+	suiteTester := &SuiteTableTester{Cases: []squareCase{
+		{Name: "zero", In: 0, Want: 0},
+		{Name: "wrong", In: 3, Want: 6},
+	}}
+	ok, output := runCaptured(t, suiteTester)
+
+	assert.False(t, ok)
+	assert.False(t, suiteTester.Passed)
+	assert.Contains(t, output, "--- FAIL: TestSuite/TestSquares/wrong")
+	assert.Contains(t, output, "Case:\t\twrong")
+	assert.NotContains(t, output, "Case:\t\tzero")
+}
+
+func TestRunTableFocus(t *testing.T) {
+	t.Setenv("CI", "")
+	suiteTester := &SuiteTableTester{Cases: []squareCase{
+		{Name: "zero", In: 0, Want: 0},
+		{Name: "two", In: 2, Want: 4, Focus: true},
+	}}
+	Run(t, suiteTester)
+
+	assert.True(t, suiteTester.Passed)
+	assert.Equal(t, []string{"TestRunTableFocus/TestSquares/two"}, suiteTester.RunCases)
+}
+
+type SuiteTableMisuseTester struct {
+	Suite
+}
+
+func (suite *SuiteTableMisuseTester) TestWrongFunc() {
+	suite.RunTable([]squareCase{{Name: "zero"}}, func(c squareCase) {})
+}
+
+func TestRunTableMisuse(t *testing.T) {
+	ok, output := runCaptured(t, new(SuiteTableMisuseTester))
+
+	assert.False(t, ok)
+	assert.Contains(t, output, "testify: RunTable needs test to be a func(*assert.Assertions, suite.squareCase)")
+}
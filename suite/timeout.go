@@ -0,0 +1,150 @@
+package suite
+
+import (
+	"bytes"
+	"flag"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+var defaultTimeout = flag.Duration("testify.timeout", 0, "how long each suite method may run before it fails, 0 for no limit")
+
+// methodTimeout returns how long the test method called testName may
+// run for.  MethodTimeoutSuite takes precedence over TimeoutSuite,
+// which takes precedence over the -testify.timeout command-line
+// argument.
+func methodTimeout(suite TestingSuite, testName string) time.Duration {
+	if methodTimeoutSuite, ok := suite.(MethodTimeoutSuite); ok {
+		if timeout := methodTimeoutSuite.MethodTimeout(testName); timeout > 0 {
+			return timeout
+		}
+	}
+	if timeoutSuite, ok := suite.(TimeoutSuite); ok {
+		if timeout := timeoutSuite.Timeout(); timeout > 0 {
+			return timeout
+		}
+	}
+	return *defaultTimeout
+}
+
+// callWithTimeout calls the test method f, called name, like
+// callHook, but on a goroutine of its own, so that it can be given up
+// on once it has run for timeout.  A method that times out fails tb
+// with its stack, and is left running: whatever it reports through
+// callHook from then on is dropped.  callWithTimeout returns whether f
+// returned, or stopped with FailNow, in time.
+func callWithTimeout(tb testing.TB, name string, timeout time.Duration, f func()) bool {
+	if timeout <= 0 {
+		callHook(tb, name, f)
+		return true
+	}
+
+	method := &methodTB{TB: tb}
+	headers := make(chan []byte, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		headers <- goroutineHeader()
+		callHook(method, name, f)
+	}()
+	header := <-headers
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return true
+	case <-timer.C:
+	}
+	method.timeOut()
+	tb.Errorf("testify: %s timed out after %v\n\n%s", name, timeout, goroutineStack(header))
+	return false
+}
+
+// methodTB passes the failures of a test method run by callWithTimeout
+// on to the testing.TB it embeds, until the method times out.
+type methodTB struct {
+	testing.TB
+
+	mutex    sync.Mutex
+	timedOut bool
+}
+
+func (m *methodTB) Errorf(format string, args ...interface{}) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if !m.timedOut {
+		m.TB.Errorf(format, args...)
+	}
+}
+
+func (m *methodTB) timeOut() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.timedOut = true
+}
+
+// abandon binds suite to a sink, once a test method that timed out on
+// it and was left running is done with, so that the method cannot
+// report through the suite to a test that has completed.  T() returns
+// nil from then on.
+func abandon(suite TestingSuite) {
+	suite.SetT(nil)
+	if setter, ok := suite.(tbSetter); ok {
+		setter.setTB(discardTB{})
+	}
+}
+
+// discardTB drops everything reported to it.  Methods that stop the
+// test stop the calling goroutine instead.
+type discardTB struct {
+	testing.TB
+}
+
+func (discardTB) Error(args ...interface{})                 {}
+func (discardTB) Errorf(format string, args ...interface{}) {}
+func (discardTB) Fail()                                     {}
+func (discardTB) FailNow()                                  { runtime.Goexit() }
+func (discardTB) Failed() bool                              { return false }
+func (discardTB) Fatal(args ...interface{})                 { runtime.Goexit() }
+func (discardTB) Fatalf(format string, args ...interface{}) { runtime.Goexit() }
+func (discardTB) Helper()                                   {}
+func (discardTB) Log(args ...interface{})                   {}
+func (discardTB) Logf(format string, args ...interface{})   {}
+func (discardTB) Skip(args ...interface{})                  { runtime.Goexit() }
+func (discardTB) SkipNow()                                  { runtime.Goexit() }
+func (discardTB) Skipf(format string, args ...interface{})  { runtime.Goexit() }
+
+// goroutineHeader returns the start of the first line of the stack
+// of the calling goroutine, "goroutine N [", which identifies it.
+func goroutineHeader() []byte {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	if i := bytes.IndexByte(buf, '['); i >= 0 {
+		buf = buf[:i+1]
+	}
+	return buf
+}
+
+// goroutineStack returns the stack of the goroutine identified by
+// header, as returned by goroutineHeader.
+func goroutineStack(header []byte) []byte {
+	buf := make([]byte, 1<<16)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	for _, stack := range bytes.Split(buf, []byte("\n\n")) {
+		if bytes.HasPrefix(stack, header) {
+			return stack
+		}
+	}
+	return buf
+}
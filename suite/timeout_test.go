@@ -0,0 +1,104 @@
+package suite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type SuiteTimeoutTester struct {
+	Suite
+
+	// TestHangs waits for a message on Release, which it asserts is
+	// empty, and closes Released once it is done.
+	Release  chan string
+	Released chan struct{}
+
+	TearDownTestRunCount int
+	TestQuickRunCount    int
+}
+
+func newSuiteTimeoutTester() *SuiteTimeoutTester {
+	return &SuiteTimeoutTester{
+		Release:  make(chan string, 1),
+		Released: make(chan struct{}),
+	}
+}
+
+func (suite *SuiteTimeoutTester) Timeout() time.Duration {
+	return time.Minute
+}
+
+func (suite *SuiteTimeoutTester) MethodTimeout(testName string) time.Duration {
+	if testName == "TestHangs" {
+		return 10 * time.Millisecond
+	}
+	return 0
+}
+
+func (suite *SuiteTimeoutTester) TearDownTest() {
+	suite.TearDownTestRunCount++
+}
+
+func (suite *SuiteTimeoutTester) TestHangs() {
+	defer close(suite.Released)
+	message := <-suite.Release
+	suite.Require().Empty(message)
+}
+
+func (suite *SuiteTimeoutTester) TestFailNow() {
+	suite.T().FailNow()
+}
+
+func (suite *SuiteTimeoutTester) TestQuick() {
+	suite.TestQuickRunCount++
+}
+
+func TestSuiteTimeout(t *testing.T) {
+	suiteTester := newSuiteTimeoutTester()
+	ok, output := runCaptured(t, suiteTester)
+
+	assert.False(t, ok)
+	assert.Contains(t, output, "--- FAIL: TestSuite/TestHangs")
+	assert.Contains(t, output, "testify: TestHangs timed out after 10ms")
+	// The stack of the method shows where it is stuck.
+	assert.Contains(t, output, "(*SuiteTimeoutTester).TestHangs")
+
+	// The method was torn down, and the suite moved on.
+	assert.Equal(t, 3, suiteTester.TearDownTestRunCount)
+	assert.Equal(t, 1, suiteTester.TestQuickRunCount)
+
+	// The method is still running, and what it reports now that the
+	// suite is done is dropped.
+	suiteTester.Release <- "failure after the suite is done"
+	<-suiteTester.Released
+	assert.Nil(t, suiteTester.T())
+}
+
+func TestSuiteTimeoutNotReached(t *testing.T) {
+	suiteTester := newSuiteTimeoutTester()
+	suiteTester.Release <- ""
+	ok, output := runCaptured(t, suiteTester)
+
+	assert.False(t, ok)
+	assert.NotContains(t, output, "timed out")
+
+	// FailNow from a method run under a timeout stops the method, but
+	// not the suite.
+	assert.Contains(t, output, "--- FAIL: TestSuite/TestFailNow")
+	assert.NotContains(t, output, "--- FAIL: TestSuite/TestHangs")
+
+	assert.Equal(t, 1, suiteTester.TestQuickRunCount)
+	assert.Equal(t, 3, suiteTester.TearDownTestRunCount)
+	assert.NotNil(t, suiteTester.T())
+}
+
+func TestMethodTimeout(t *testing.T) {
+	suiteTester := new(SuiteTimeoutTester)
+	assert.Equal(t, 10*time.Millisecond, methodTimeout(suiteTester, "TestHangs"))
+	assert.Equal(t, time.Minute, methodTimeout(suiteTester, "TestQuick"))
+
+	setFlag(t, "testify.timeout", "1s")
+	assert.Equal(t, time.Second, methodTimeout(new(SuiteTester), "TestOne"))
+}